@@ -0,0 +1,203 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	pb "github.com/jtomic1/config-schema-service/proto"
+)
+
+// archiveChunkSize is the size of the chunks in which archives are sent.
+const archiveChunkSize = 64 << 10
+
+var archiveFormats = map[string]pb.ArchiveFormat{
+	"ndjson": pb.ArchiveFormat_NDJSON,
+	"tar":    pb.ArchiveFormat_TAR,
+	"zip":    pb.ArchiveFormat_ZIP,
+}
+
+var conflictPolicies = map[string]pb.ImportConflictPolicy{
+	"fail":                   pb.ImportConflictPolicy_FAIL_ON_CONFLICT,
+	"skip":                   pb.ImportConflictPolicy_SKIP_EXISTING,
+	"overwrite-if-identical": pb.ImportConflictPolicy_OVERWRITE_IF_IDENTICAL,
+}
+
+// archiveFormat returns the format given with -format, or the format which matches the extension of the file.
+func archiveFormat(format string, file string) (pb.ArchiveFormat, error) {
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(file)) {
+		case ".tar":
+			format = "tar"
+		case ".zip":
+			format = "zip"
+		default:
+			format = "ndjson"
+		}
+	}
+	archiveFormat, ok := archiveFormats[format]
+	if !ok {
+		return 0, usagef("unknown archive format '%s'", format)
+	}
+	return archiveFormat, nil
+}
+
+var exportOptions struct {
+	format string
+	file   string
+}
+
+var exportCommand = &command{
+	usage:       "[-format ndjson|tar|zip] [-f file] organization[/namespace[/name]]",
+	description: "Export every version of an organization, namespace or schema, with metadata and creation times, to an archive. The format is taken from the extension of the file if it is not given.",
+	flags: func(fs *flag.FlagSet) {
+		fs.StringVar(&exportOptions.format, "format", "", "format of the archive: ndjson, tar or zip")
+		fs.StringVar(&exportOptions.file, "f", "", "file which the archive is written to, instead of the standard output")
+	},
+	run: func(c *cli, _ *flag.FlagSet, args []string) error {
+		if len(args) != 1 {
+			return usagef("expected an organization, namespace or schema")
+		}
+		format, err := archiveFormat(exportOptions.format, exportOptions.file)
+		if err != nil {
+			return err
+		}
+		parts := strings.Split(args[0], "/")
+		if len(parts) > 3 {
+			return usagef("'%s' is not of the form organization[/namespace[/name]]", args[0])
+		}
+		parts = append(parts, "", "")
+		out := c.out
+		if exportOptions.file != "" {
+			file, err := os.Create(exportOptions.file)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			out = file
+		}
+		ctx, cancel := c.call()
+		defer cancel()
+		stream, err := c.client.ExportConfigSchemas(ctx, &pb.ExportConfigSchemasRequest{
+			Organization: parts[0],
+			Namespace:    parts[1],
+			SchemaName:   parts[2],
+			Format:       format,
+		})
+		if err == nil {
+			err = receiveArchive(stream, out)
+		}
+		if err != nil && exportOptions.file != "" {
+			os.Remove(exportOptions.file)
+		}
+		return err
+	},
+}
+
+// receiveArchive writes the chunks of the archive until the server reports that the export is complete.
+func receiveArchive(stream pb.ConfigSchemaService_ExportConfigSchemasClient, out io.Writer) error {
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return errors.New("export ended before it was complete")
+		} else if err != nil {
+			return err
+		}
+		if err := statusError(resp.GetStatus(), resp.GetMessage()); err != nil {
+			return err
+		}
+		if len(resp.GetChunk()) == 0 {
+			fmt.Fprintln(os.Stderr, resp.GetMessage())
+			return nil
+		}
+		if _, err := out.Write(resp.GetChunk()); err != nil {
+			return err
+		}
+	}
+}
+
+var importOptions struct {
+	format     string
+	onConflict string
+	dryRun     bool
+}
+
+var importCommand = &command{
+	usage:       "[-format ndjson|tar|zip] [-on-conflict fail|skip|overwrite-if-identical] [-dry-run] file",
+	description: "Import the versions of an archive made by export. Versions which already exist abort the import, unless they are skipped or, with overwrite-if-identical, have the same content. Exits with 1 if the import is aborted or any version is rejected.",
+	flags: func(fs *flag.FlagSet) {
+		fs.StringVar(&importOptions.format, "format", "", "format of the archive: ndjson, tar or zip")
+		fs.StringVar(&importOptions.onConflict, "on-conflict", "fail", "what to do with versions which already exist: fail, skip or overwrite-if-identical")
+		fs.BoolVar(&importOptions.dryRun, "dry-run", false, "only report what would be imported")
+	},
+	run: func(c *cli, _ *flag.FlagSet, args []string) error {
+		if len(args) != 1 {
+			return usagef("expected one archive")
+		}
+		format, err := archiveFormat(importOptions.format, args[0])
+		if err != nil {
+			return err
+		}
+		policy, ok := conflictPolicies[importOptions.onConflict]
+		if !ok {
+			return usagef("unknown conflict policy '%s'", importOptions.onConflict)
+		}
+		archive, err := os.ReadFile(args[0])
+		if err != nil {
+			return err
+		}
+		ctx, cancel := c.call()
+		defer cancel()
+		stream, err := c.client.ImportConfigSchemas(ctx)
+		if err != nil {
+			return err
+		}
+		for offset := 0; offset == 0 || offset < len(archive); offset += archiveChunkSize {
+			req := &pb.ImportConfigSchemasRequest{Chunk: archive[offset:min(len(archive), offset+archiveChunkSize)]}
+			if offset == 0 {
+				req.Format = format
+				req.ConflictPolicy = policy
+				req.DryRun = importOptions.dryRun
+			}
+			// a failed send is reported by CloseAndRecv
+			if err := stream.Send(req); err == io.EOF {
+				break
+			} else if err != nil {
+				return err
+			}
+		}
+		resp, err := stream.CloseAndRecv()
+		if err != nil {
+			return err
+		}
+		failed := resp.GetStatus() != 0
+		for _, result := range resp.GetResults() {
+			outcome := result.GetOutcome()
+			failed = failed || outcome == pb.ImportOutcome_REJECTED || outcome == pb.ImportOutcome_CONFLICTING
+		}
+		err = c.writeMessage(resp, func(w io.Writer) error {
+			results := &table{header: []string{"SCHEMA", "OUTCOME", "MESSAGE"}}
+			for _, result := range resp.GetResults() {
+				results.add(formatRef(result.GetSchemaDetails()), result.GetOutcome().String(), result.GetMessage())
+			}
+			if len(results.rows) > 0 {
+				if err := results.write(w); err != nil {
+					return err
+				}
+			}
+			_, err := fmt.Fprintln(w, resp.GetMessage())
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		if failed {
+			return errFailed
+		}
+		return nil
+	},
+}
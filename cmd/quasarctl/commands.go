@@ -0,0 +1,562 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jtomic1/config-schema-service/pkg/validation"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"sigs.k8s.io/yaml"
+)
+
+// pageSize is the size of the pages which are requested when listing.
+const pageSize = 100
+
+// labelsFlag collects repeated -label key=value flags.
+type labelsFlag map[string]string
+
+func (f labelsFlag) String() string {
+	return formatLabels(f)
+}
+
+func (f labelsFlag) Set(value string) error {
+	key, labelValue, ok := strings.Cut(value, "=")
+	if !ok || key == "" {
+		return fmt.Errorf("label '%s' is not of the form key=value", value)
+	}
+	f[key] = labelValue
+	return nil
+}
+
+// listFlag collects repeated flags, such as -tag.
+type listFlag []string
+
+func (f *listFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *listFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+// schemaManifest is a file which describes a schema version together with the schema, which can be
+// given as a YAML or JSON document, or as a string.
+type schemaManifest struct {
+	Organization string            `json:"organization"`
+	Namespace    string            `json:"namespace"`
+	SchemaName   string            `json:"schemaName"`
+	Version      string            `json:"version"`
+	Description  string            `json:"description"`
+	Labels       map[string]string `json:"labels"`
+	Tags         []string          `json:"tags"`
+	Schema       json.RawMessage   `json:"schema"`
+}
+
+// readSchema reads a schema written as YAML or JSON and returns it as JSON.
+func readSchema(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	schema, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", path, err)
+	}
+	return string(schema), nil
+}
+
+func readManifest(path string) (*pb.SaveConfigSchemaRequest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var manifest schemaManifest
+	if err := yaml.UnmarshalStrict(data, &manifest); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	schema := string(manifest.Schema)
+	var schemaText string
+	if err := json.Unmarshal(manifest.Schema, &schemaText); err == nil {
+		schema = schemaText
+	}
+	return &pb.SaveConfigSchemaRequest{
+		SchemaDetails: &pb.ConfigSchemaDetails{
+			Organization: manifest.Organization,
+			Namespace:    manifest.Namespace,
+			SchemaName:   manifest.SchemaName,
+			Version:      manifest.Version,
+		},
+		Schema:      schema,
+		Description: manifest.Description,
+		Labels:      manifest.Labels,
+		Tags:        manifest.Tags,
+	}, nil
+}
+
+var pushOptions struct {
+	ref         string
+	description string
+	labels      labelsFlag
+	tags        listFlag
+}
+
+var pushCommand = &command{
+	usage:       "[-ref organization/namespace/name/version] file...",
+	description: "Save schema versions. Each file is a manifest with the organization, namespace, schemaName, version, description, labels, tags and schema of a version. With -ref, the file holds only the schema and the other fields are given with flags.",
+	flags: func(fs *flag.FlagSet) {
+		pushOptions.labels = make(labelsFlag)
+		fs.StringVar(&pushOptions.ref, "ref", "", "version which the schema file is saved as")
+		fs.StringVar(&pushOptions.description, "description", "", "description of the version (with -ref)")
+		fs.Var(pushOptions.labels, "label", "label of the version as key=value (with -ref, repeatable)")
+		fs.Var(&pushOptions.tags, "tag", "tag of the version (with -ref, repeatable)")
+	},
+	run: func(c *cli, _ *flag.FlagSet, args []string) error {
+		if len(args) == 0 {
+			return usagef("no files given")
+		}
+		var requests []*pb.SaveConfigSchemaRequest
+		if pushOptions.ref != "" {
+			if len(args) != 1 {
+				return usagef("exactly one schema file must be given with -ref")
+			}
+			details, err := parseRef(pushOptions.ref, true)
+			if err != nil {
+				return err
+			}
+			schema, err := readSchema(args[0])
+			if err != nil {
+				return err
+			}
+			requests = append(requests, &pb.SaveConfigSchemaRequest{
+				SchemaDetails: details,
+				Schema:        schema,
+				Description:   pushOptions.description,
+				Labels:        pushOptions.labels,
+				Tags:          pushOptions.tags,
+			})
+		} else {
+			for _, path := range args {
+				request, err := readManifest(path)
+				if err != nil {
+					return err
+				}
+				requests = append(requests, request)
+			}
+		}
+
+		failed := false
+		results := &table{header: []string{"SCHEMA", "STATUS", "MESSAGE"}}
+		for _, request := range requests {
+			ctx, cancel := c.call()
+			resp, err := c.client.SaveConfigSchema(ctx, request)
+			cancel()
+			if err != nil {
+				return err
+			}
+			failed = failed || resp.GetStatus() != 0
+			results.add(formatRef(request.GetSchemaDetails()), fmt.Sprint(resp.GetStatus()), resp.GetMessage())
+		}
+		if err := c.writeValue(tableRecords(results), results.write); err != nil {
+			return err
+		}
+		if failed {
+			return errFailed
+		}
+		return nil
+	},
+}
+
+// tableRecords turns the rows of a table into records for the JSON and YAML output.
+func tableRecords(t *table) []map[string]string {
+	records := make([]map[string]string, len(t.rows))
+	for i, row := range t.rows {
+		records[i] = make(map[string]string, len(row))
+		for j, value := range row {
+			records[i][strings.ToLower(t.header[j])] = value
+		}
+	}
+	return records
+}
+
+var getOptions struct {
+	schemaOnly bool
+}
+
+var getCommand = &command{
+	usage:       "organization/namespace/name/version",
+	description: "Show a schema version.",
+	flags: func(fs *flag.FlagSet) {
+		fs.BoolVar(&getOptions.schemaOnly, "schema-only", false, "print only the schema, as YAML in the table format")
+	},
+	run: func(c *cli, _ *flag.FlagSet, args []string) error {
+		if len(args) != 1 {
+			return usagef("expected one schema reference")
+		}
+		details, err := parseRef(args[0], true)
+		if err != nil {
+			return err
+		}
+		schemaData, err := c.getSchema(details)
+		if err != nil {
+			return err
+		}
+		if getOptions.schemaOnly {
+			if c.opts.output == "json" {
+				return c.writeData([]byte(schemaData.GetSchema()))
+			}
+			schema, err := schemaYAML(schemaData.GetSchema())
+			if err != nil {
+				return err
+			}
+			_, err = io.WriteString(c.out, schema)
+			return err
+		}
+		return c.writeMessage(&pb.ConfigSchema{SchemaDetails: details, SchemaData: schemaData}, func(w io.Writer) error {
+			schema, err := schemaYAML(schemaData.GetSchema())
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "Schema:      %s\n", formatRef(details))
+			fmt.Fprintf(w, "Description: %s\n", schemaData.GetDescription())
+			fmt.Fprintf(w, "Created:     %s by %s\n", schemaData.GetCreationTime().AsTime().Format("2006-01-02 15:04:05 MST"), schemaData.GetCreatedBy())
+			fmt.Fprintf(w, "Labels:      %s\n", formatLabels(schemaData.GetLabels()))
+			fmt.Fprintf(w, "Tags:        %s\n", strings.Join(schemaData.GetTags(), ","))
+			fmt.Fprintf(w, "State:       %s\n", formatLifecycle(schemaData.GetLifecycle()))
+			_, err = fmt.Fprintf(w, "---\n%s", schema)
+			return err
+		})
+	},
+}
+
+func (c *cli) getSchema(details *pb.ConfigSchemaDetails) (*pb.ConfigSchemaData, error) {
+	ctx, cancel := c.call()
+	defer cancel()
+	resp, err := c.client.GetConfigSchema(ctx, &pb.GetConfigSchemaRequest{SchemaDetails: details})
+	if err != nil {
+		return nil, err
+	}
+	if err := statusError(resp.GetStatus(), resp.GetMessage()); err != nil {
+		return nil, err
+	}
+	return resp.GetSchemaData(), nil
+}
+
+func formatLifecycle(lifecycle *pb.ConfigSchemaLifecycle) string {
+	state := lifecycle.GetState().String()
+	if lifecycle.GetReplacementVersion() != "" {
+		state += ", replaced by " + lifecycle.GetReplacementVersion()
+	}
+	if lifecycle.GetMessage() != "" {
+		state += ": " + lifecycle.GetMessage()
+	}
+	return state
+}
+
+var deleteCommand = &command{
+	usage:       "organization/namespace/name/version",
+	description: "Delete a schema version. It can be restored until the retention period of the server expires.",
+	run: func(c *cli, _ *flag.FlagSet, args []string) error {
+		if len(args) != 1 {
+			return usagef("expected one schema reference")
+		}
+		details, err := parseRef(args[0], true)
+		if err != nil {
+			return err
+		}
+		ctx, cancel := c.call()
+		defer cancel()
+		resp, err := c.client.DeleteConfigSchema(ctx, &pb.DeleteConfigSchemaRequest{SchemaDetails: details})
+		if err != nil {
+			return err
+		}
+		if err := statusError(resp.GetStatus(), resp.GetMessage()); err != nil {
+			return err
+		}
+		return c.writeMessage(resp, func(w io.Writer) error {
+			_, err := fmt.Fprintln(w, resp.GetMessage())
+			return err
+		})
+	},
+}
+
+var versionsOptions struct {
+	versionRange string
+	descending   bool
+	labels       labelsFlag
+	tags         listFlag
+}
+
+var versionsCommand = &command{
+	usage:       "organization/namespace/name",
+	description: "List the versions of a schema.",
+	flags: func(fs *flag.FlagSet) {
+		versionsOptions.labels = make(labelsFlag)
+		fs.StringVar(&versionsOptions.versionRange, "range", "", "range of the listed versions, e.g. \">=v1.2.0 <v2.0.0\"")
+		fs.BoolVar(&versionsOptions.descending, "desc", false, "list the newest versions first")
+		fs.Var(versionsOptions.labels, "label", "only list versions with the label key=value (repeatable)")
+		fs.Var(&versionsOptions.tags, "tag", "only list versions with the tag (repeatable)")
+	},
+	run: func(c *cli, _ *flag.FlagSet, args []string) error {
+		if len(args) != 1 {
+			return usagef("expected one schema reference")
+		}
+		details, err := parseRef(args[0], false)
+		if err != nil {
+			return err
+		}
+		order := pb.VersionOrder_ASCENDING
+		if versionsOptions.descending {
+			order = pb.VersionOrder_DESCENDING
+		}
+		all := &pb.ConfigSchemaVersionsResponse{}
+		request := &pb.ConfigSchemaVersionsRequest{
+			SchemaDetails: details,
+			Filter:        &pb.ConfigSchemaMetadataFilter{Labels: versionsOptions.labels, Tags: versionsOptions.tags},
+			PageSize:      pageSize,
+			Order:         order,
+			VersionRange:  versionsOptions.versionRange,
+			View:          pb.ConfigSchemaView_SUMMARY,
+		}
+		for {
+			ctx, cancel := c.call()
+			resp, err := c.client.GetConfigSchemaVersions(ctx, request)
+			cancel()
+			if err != nil {
+				return err
+			}
+			if err := statusError(resp.GetStatus(), resp.GetMessage()); err != nil {
+				return err
+			}
+			all.SchemaVersions = append(all.SchemaVersions, resp.GetSchemaVersions()...)
+			if resp.GetNextPageToken() == "" {
+				break
+			}
+			request.PageToken = resp.GetNextPageToken()
+		}
+		return c.writeMessage(all, func(w io.Writer) error {
+			versions := &table{header: []string{"VERSION", "STATE", "CREATED", "CREATED BY", "LABELS", "TAGS", "DESCRIPTION"}}
+			for _, version := range all.GetSchemaVersions() {
+				data := version.GetSchemaData()
+				versions.add(
+					version.GetSchemaDetails().GetVersion(),
+					data.GetLifecycle().GetState().String(),
+					data.GetCreationTime().AsTime().Format("2006-01-02 15:04"),
+					data.GetCreatedBy(),
+					formatLabels(data.GetLabels()),
+					strings.Join(data.GetTags(), ","),
+					truncate(data.GetDescription(), 50),
+				)
+			}
+			return versions.write(w)
+		})
+	},
+}
+
+// validationResult is the outcome of validating one configuration file.
+type validationResult struct {
+	File    string `json:"file"`
+	Valid   bool   `json:"valid"`
+	Message string `json:"message"`
+	Warning string `json:"warning,omitempty"`
+}
+
+// configurationFiles returns the files of the paths, walking directories for YAML and JSON files.
+func configurationFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		err = filepath.WalkDir(path, func(file string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			switch strings.ToLower(filepath.Ext(file)) {
+			case ".yaml", ".yml", ".json":
+				if !entry.IsDir() {
+					files = append(files, file)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+var validateOptions struct {
+	schemaFile string
+}
+
+var validateCommand = &command{
+	usage:       "organization/namespace/name/version file|directory... | -schema-file schema file|directory...",
+	description: "Validate configurations against a schema version, or offline against a schema file with the same validation as the server. Directories are searched for .yaml, .yml and .json files. Exits with 1 if any configuration is invalid.",
+	flags: func(fs *flag.FlagSet) {
+		fs.StringVar(&validateOptions.schemaFile, "schema-file", "", "validate offline against the schema in the file instead of a saved version")
+	},
+	run: func(c *cli, _ *flag.FlagSet, args []string) error {
+		var validate func(configuration string) (validationResult, error)
+		if validateOptions.schemaFile != "" {
+			if len(args) < 1 {
+				return usagef("expected at least one file or directory")
+			}
+			schema, err := os.ReadFile(validateOptions.schemaFile)
+			if err != nil {
+				return err
+			}
+			compiled, err := validation.CompileSchema(string(schema))
+			if err != nil {
+				return fmt.Errorf("%s: %w", validateOptions.schemaFile, err)
+			}
+			validate = func(configuration string) (validationResult, error) {
+				result, err := compiled.Validate(configuration)
+				if err != nil {
+					return validationResult{Valid: false, Message: err.Error()}, nil
+				}
+				return validationResult{Valid: result.Valid, Message: result.Message}, nil
+			}
+		} else {
+			if len(args) < 2 {
+				return usagef("expected a schema reference and at least one file or directory")
+			}
+			details, err := parseRef(args[0], true)
+			if err != nil {
+				return err
+			}
+			args = args[1:]
+			validate = func(configuration string) (validationResult, error) {
+				ctx, cancel := c.call()
+				defer cancel()
+				resp, err := c.client.ValidateConfiguration(ctx, &pb.ValidateConfigurationRequest{
+					SchemaDetails: details,
+					Configuration: configuration,
+				})
+				if err != nil {
+					return validationResult{}, err
+				}
+				return validationResult{
+					Valid:   resp.GetStatus() == 0 && resp.GetIsValid(),
+					Message: resp.GetMessage(),
+					Warning: resp.GetWarning(),
+				}, nil
+			}
+		}
+		files, err := configurationFiles(args)
+		if err != nil {
+			return err
+		}
+		if len(files) == 0 {
+			return errors.New("no configuration files found")
+		}
+		var results []validationResult
+		for _, file := range files {
+			configuration, err := os.ReadFile(file)
+			if err != nil {
+				return err
+			}
+			result, err := validate(string(configuration))
+			if err != nil {
+				return err
+			}
+			result.File = file
+			results = append(results, result)
+		}
+		failed := false
+		err = c.writeValue(results, func(w io.Writer) error {
+			for _, result := range results {
+				outcome := "PASS"
+				if !result.Valid {
+					outcome = "FAIL"
+				}
+				fmt.Fprintf(w, "%s  %s: %s\n", outcome, result.File, result.Message)
+				if result.Warning != "" {
+					fmt.Fprintf(w, "      warning: %s\n", result.Warning)
+				}
+			}
+			return nil
+		})
+		for _, result := range results {
+			failed = failed || !result.Valid
+		}
+		if err != nil {
+			return err
+		}
+		if failed {
+			return errFailed
+		}
+		return nil
+	},
+}
+
+var listOptions struct {
+	labels labelsFlag
+	tags   listFlag
+}
+
+var listCommand = &command{
+	usage:       "organization[/namespace]",
+	description: "List the schemas of an organization or namespace, with their latest versions.",
+	flags: func(fs *flag.FlagSet) {
+		listOptions.labels = make(labelsFlag)
+		fs.Var(listOptions.labels, "label", "only list schemas with the label key=value (repeatable)")
+		fs.Var(&listOptions.tags, "tag", "only list schemas with the tag (repeatable)")
+	},
+	run: func(c *cli, _ *flag.FlagSet, args []string) error {
+		if len(args) != 1 {
+			return usagef("expected an organization or organization/namespace")
+		}
+		organization, namespace, _ := strings.Cut(args[0], "/")
+		all := &pb.ListConfigSchemasResponse{}
+		request := &pb.ListConfigSchemasRequest{
+			Organization: organization,
+			Namespace:    namespace,
+			PageSize:     pageSize,
+			Filter:       &pb.ConfigSchemaMetadataFilter{Labels: listOptions.labels, Tags: listOptions.tags},
+		}
+		for {
+			ctx, cancel := c.call()
+			resp, err := c.client.ListConfigSchemas(ctx, request)
+			cancel()
+			if err != nil {
+				return err
+			}
+			if err := statusError(resp.GetStatus(), resp.GetMessage()); err != nil {
+				return err
+			}
+			all.Schemas = append(all.Schemas, resp.GetSchemas()...)
+			if resp.GetNextPageToken() == "" {
+				break
+			}
+			request.PageToken = resp.GetNextPageToken()
+		}
+		return c.writeMessage(all, func(w io.Writer) error {
+			schemas := &table{header: []string{"NAMESPACE", "NAME", "LATEST", "VERSIONS", "DESCRIPTION"}}
+			for _, summary := range all.GetSchemas() {
+				details := summary.GetSchemaDetails()
+				schemas.add(
+					details.GetNamespace(),
+					details.GetSchemaName(),
+					details.GetVersion(),
+					fmt.Sprint(summary.GetVersionCount()),
+					truncate(summary.GetSchemaData().GetDescription(), 50),
+				)
+			}
+			return schemas.write(w)
+		})
+	},
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+var diffCommand = &command{
+	usage:       "file|organization/namespace/name/version file|organization/namespace/name/version",
+	description: "Show the differences between two schemas, each given as a file or a saved version. Exits with 1 if the schemas differ.",
+	run: func(c *cli, _ *flag.FlagSet, args []string) error {
+		if len(args) != 2 {
+			return usagef("expected two schemas")
+		}
+		from, err := c.loadSchema(args[0])
+		if err != nil {
+			return err
+		}
+		to, err := c.loadSchema(args[1])
+		if err != nil {
+			return err
+		}
+		edits := diffLines(strings.Split(from, "\n"), strings.Split(to, "\n"))
+		changed := false
+		for _, e := range edits {
+			changed = changed || e.op != ' '
+		}
+		err = c.writeValue(diffRecords(edits), func(w io.Writer) error {
+			if !changed {
+				return nil
+			}
+			fmt.Fprintf(w, "--- %s\n+++ %s\n", args[0], args[1])
+			for _, e := range edits {
+				fmt.Fprintf(w, "%c%s\n", e.op, e.line)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if changed {
+			return errFailed
+		}
+		return nil
+	},
+}
+
+// loadSchema reads the schema from the file if it exists, or gets the saved version otherwise. The schema
+// is returned as YAML with sorted keys, so that formatting does not show up as differences.
+func (c *cli) loadSchema(fileOrRef string) (string, error) {
+	var schema string
+	if _, err := os.Stat(fileOrRef); err == nil {
+		if schema, err = readSchema(fileOrRef); err != nil {
+			return "", err
+		}
+	} else {
+		details, err := parseRef(fileOrRef, true)
+		if err != nil {
+			return "", err
+		}
+		data, err := c.getSchema(details)
+		if err != nil {
+			return "", err
+		}
+		schema = data.GetSchema()
+	}
+	normalized, err := schemaYAML(schema)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(normalized, "\n"), nil
+}
+
+// edit is a line of a diff: ' ' for a line in both schemas, '-' for a removed and '+' for an added line.
+type edit struct {
+	op   byte
+	line string
+}
+
+// diffLines returns the edits which turn a into b, following the longest common subsequence of the lines.
+func diffLines(a, b []string) []edit {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	var edits []edit
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			edits = append(edits, edit{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			edits = append(edits, edit{'-', a[i]})
+			i++
+		default:
+			edits = append(edits, edit{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		edits = append(edits, edit{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		edits = append(edits, edit{'+', b[j]})
+	}
+	return edits
+}
+
+// diffRecords returns the removed and added lines for the JSON and YAML output.
+func diffRecords(edits []edit) map[string][]string {
+	records := map[string][]string{"removed": {}, "added": {}}
+	for _, e := range edits {
+		switch e.op {
+		case '-':
+			records["removed"] = append(records["removed"], e.line)
+		case '+':
+			records["added"] = append(records["added"], e.line)
+		}
+	}
+	return records
+}
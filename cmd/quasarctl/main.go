@@ -0,0 +1,249 @@
+// Command quasarctl manages the schemas of the config schema service.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jtomic1/config-schema-service/internal/certs"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	exitFailure = 1
+	exitUsage   = 2
+)
+
+// globalOptions are accepted before and after the name of the command.
+type globalOptions struct {
+	server     string
+	token      string
+	profile    string
+	output     string
+	timeout    time.Duration
+	tls        bool
+	caFile     string
+	certFile   string
+	keyFile    string
+	serverName string
+}
+
+func (opts *globalOptions) register(fs *flag.FlagSet) {
+	fs.StringVar(&opts.server, "server", opts.server, "address of the server (QUASAR_SERVER, localhost:50051 by default)")
+	fs.StringVar(&opts.token, "token", opts.token, "token sent with every call (QUASAR_TOKEN)")
+	fs.StringVar(&opts.profile, "profile", opts.profile, "profile of the configuration file (QUASAR_PROFILE)")
+	fs.StringVar(&opts.output, "o", opts.output, "output format: table, json or yaml")
+	fs.DurationVar(&opts.timeout, "timeout", opts.timeout, "timeout of every call")
+	fs.BoolVar(&opts.tls, "tls", opts.tls, "connect over TLS")
+	fs.StringVar(&opts.caFile, "ca-file", opts.caFile, "CA which verifies the server")
+	fs.StringVar(&opts.certFile, "cert-file", opts.certFile, "client certificate")
+	fs.StringVar(&opts.keyFile, "key-file", opts.keyFile, "private key of the client certificate")
+	fs.StringVar(&opts.serverName, "server-name", opts.serverName, "name which the server certificate is verified against")
+}
+
+// cli is the state shared by the commands.
+type cli struct {
+	opts   *globalOptions
+	client pb.ConfigSchemaServiceClient
+	token  string
+	out    io.Writer
+}
+
+// call returns the context of a call, which carries the token and is bounded by the timeout.
+func (c *cli) call() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.opts.timeout)
+	if c.token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authz-token", c.token)
+	}
+	return ctx, cancel
+}
+
+type command struct {
+	usage       string
+	description string
+	run         func(c *cli, fs *flag.FlagSet, args []string) error
+	// flags registers the flags of the command
+	flags func(fs *flag.FlagSet)
+}
+
+var commands = map[string]*command{
+	"push":     pushCommand,
+	"get":      getCommand,
+	"delete":   deleteCommand,
+	"versions": versionsCommand,
+	"validate": validateCommand,
+	"diff":     diffCommand,
+	"list":     listCommand,
+	"export":   exportCommand,
+	"import":   importCommand,
+}
+
+// usageError is reported with the usage of the command.
+type usageError struct {
+	message string
+}
+
+func (e *usageError) Error() string {
+	return e.message
+}
+
+func usagef(format string, args ...interface{}) error {
+	return &usageError{message: fmt.Sprintf(format, args...)}
+}
+
+// errFailed is returned by commands which have already reported why they failed, e.g. invalid configurations.
+var errFailed = errors.New("failed")
+
+func printUsage() {
+	fmt.Fprintln(os.Stderr, "Usage: quasarctl [flags] <command> [flags] [arguments]")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Commands:")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-9s %s\n", name, commands[name].description)
+	}
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Flags:")
+	fs := flag.NewFlagSet("quasarctl", flag.ContinueOnError)
+	(&globalOptions{}).register(fs)
+	fs.SetOutput(os.Stderr)
+	fs.PrintDefaults()
+}
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+func run(args []string) int {
+	opts := &globalOptions{output: "table", timeout: 30 * time.Second}
+	root := flag.NewFlagSet("quasarctl", flag.ContinueOnError)
+	root.Usage = printUsage
+	opts.register(root)
+	if err := root.Parse(args); err != nil {
+		return exitUsage
+	}
+	if root.NArg() == 0 {
+		printUsage()
+		return exitUsage
+	}
+	name := root.Arg(0)
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "quasarctl: unknown command '%s'\n\n", name)
+		printUsage()
+		return exitUsage
+	}
+	fs := flag.NewFlagSet("quasarctl "+name, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: quasarctl %s %s\n\n%s\n\nFlags:\n", name, cmd.usage, cmd.description)
+		fs.PrintDefaults()
+	}
+	opts.register(fs)
+	if cmd.flags != nil {
+		cmd.flags(fs)
+	}
+	if err := fs.Parse(root.Args()[1:]); err != nil {
+		return exitUsage
+	}
+	switch opts.output {
+	case "table", "json", "yaml":
+	default:
+		fmt.Fprintf(os.Stderr, "quasarctl: unknown output format '%s'\n", opts.output)
+		return exitUsage
+	}
+
+	profile, err := opts.resolve()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "quasarctl: %v\n", err)
+		return exitFailure
+	}
+	conn, err := dial(profile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "quasarctl: %v\n", err)
+		return exitFailure
+	}
+	defer conn.Close()
+	c := &cli{
+		opts:   opts,
+		client: pb.NewConfigSchemaServiceClient(conn),
+		token:  profile.Token,
+		out:    os.Stdout,
+	}
+	err = cmd.run(c, fs, fs.Args())
+	var usageErr *usageError
+	switch {
+	case err == nil:
+		return 0
+	case errors.As(err, &usageErr):
+		fmt.Fprintf(os.Stderr, "quasarctl %s: %v\n\n", name, err)
+		fs.Usage()
+		return exitUsage
+	case errors.Is(err, errFailed):
+		return exitFailure
+	default:
+		fmt.Fprintf(os.Stderr, "quasarctl %s: %v\n", name, err)
+		return exitFailure
+	}
+}
+
+func dial(profile Profile) (*grpc.ClientConn, error) {
+	creds := insecure.NewCredentials()
+	tlsCfg, err := certs.ClientConfig(profile.TLS)
+	if err != nil {
+		return nil, err
+	}
+	if tlsCfg != nil {
+		creds = credentials.NewTLS(tlsCfg)
+	}
+	return grpc.NewClient(profile.Server, grpc.WithTransportCredentials(creds))
+}
+
+// parseRef reads a reference to a schema in the form organization/namespace/name[/version].
+func parseRef(ref string, withVersion bool) (*pb.ConfigSchemaDetails, error) {
+	parts := strings.Split(ref, "/")
+	if withVersion && len(parts) != 4 {
+		return nil, usagef("'%s' is not a reference of the form organization/namespace/name/version", ref)
+	} else if !withVersion && len(parts) != 3 {
+		return nil, usagef("'%s' is not a reference of the form organization/namespace/name", ref)
+	}
+	details := &pb.ConfigSchemaDetails{
+		Organization: parts[0],
+		Namespace:    parts[1],
+		SchemaName:   parts[2],
+	}
+	if withVersion {
+		details.Version = parts[3]
+	}
+	return details, nil
+}
+
+func formatRef(details *pb.ConfigSchemaDetails) string {
+	ref := details.GetOrganization() + "/" + details.GetNamespace() + "/" + details.GetSchemaName()
+	if details.GetVersion() != "" {
+		ref += "/" + details.GetVersion()
+	}
+	return ref
+}
+
+// statusError reports a response whose status is not 0.
+func statusError(status int32, message string) error {
+	if status == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s (status %d)", message, status)
+}
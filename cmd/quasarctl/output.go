@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"sigs.k8s.io/yaml"
+)
+
+// table is the output of a command in the table format.
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+func (t *table) add(row ...string) {
+	t.rows = append(t.rows, row)
+}
+
+func (t *table) write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(t.header, "\t"))
+	for _, row := range t.rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+var jsonOptions = protojson.MarshalOptions{
+	Multiline: true,
+	Indent:    "  ",
+}
+
+// writeMessage writes the message as JSON or YAML, or in the human-readable format otherwise.
+func (c *cli) writeMessage(msg proto.Message, human func(w io.Writer) error) error {
+	switch c.opts.output {
+	case "json", "yaml":
+		data, err := jsonOptions.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		return c.writeData(data)
+	default:
+		return human(c.out)
+	}
+}
+
+// writeValue writes a value which is not a message, such as the results of a validation.
+func (c *cli) writeValue(value interface{}, human func(w io.Writer) error) error {
+	switch c.opts.output {
+	case "json", "yaml":
+		data, err := yaml.Marshal(value)
+		if err != nil {
+			return err
+		}
+		if data, err = yaml.YAMLToJSON(data); err != nil {
+			return err
+		}
+		return c.writeData(data)
+	default:
+		return human(c.out)
+	}
+}
+
+// writeData writes a JSON document in the output format.
+func (c *cli) writeData(jsonData []byte) error {
+	if c.opts.output == "yaml" {
+		data, err := yaml.JSONToYAML(jsonData)
+		if err != nil {
+			return err
+		}
+		_, err = c.out.Write(data)
+		return err
+	}
+	if _, err := c.out.Write(jsonData); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(c.out)
+	return err
+}
+
+// schemaYAML renders a schema written as YAML or JSON as YAML with sorted keys.
+func schemaYAML(schema string) (string, error) {
+	data, err := yaml.JSONToYAML([]byte(schema))
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func formatLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	pairs := make([]string, 0, len(labels))
+	for key, value := range labels {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func truncate(text string, length int) string {
+	text = strings.ReplaceAll(text, "\n", " ")
+	if len(text) <= length {
+		return text
+	}
+	return text[:length-3] + "..."
+}
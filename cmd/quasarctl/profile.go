@@ -0,0 +1,126 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jtomic1/config-schema-service/internal/config"
+	"sigs.k8s.io/yaml"
+)
+
+const defaultServer = "localhost:50051"
+
+// Profile holds the settings of a connection to a server.
+type Profile struct {
+	Server string `json:"server"`
+	Token  string `json:"token"`
+	// TokenFile is read when no token is given, e.g. a file which is kept up to date by another tool.
+	TokenFile string                 `json:"tokenFile"`
+	TLS       config.ClientTLSConfig `json:"tls"`
+}
+
+// profileFile is the configuration file of the CLI, which holds named profiles.
+type profileFile struct {
+	CurrentProfile string             `json:"currentProfile"`
+	Profiles       map[string]Profile `json:"profiles"`
+}
+
+// profilePath returns the path of the configuration file, which can be overridden with QUASARCTL_CONFIG.
+func profilePath() (string, error) {
+	if path := os.Getenv("QUASARCTL_CONFIG"); path != "" {
+		return path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "quasarctl", "config.yaml"), nil
+}
+
+// loadProfile returns the named profile, or the current profile of the file if no name is given.
+// A missing configuration file is treated as empty, unless a profile is requested by name.
+func loadProfile(name string) (Profile, error) {
+	path, err := profilePath()
+	if err != nil {
+		return Profile{}, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) && name == "" {
+		return Profile{}, nil
+	} else if err != nil {
+		return Profile{}, fmt.Errorf("reading profiles: %w", err)
+	}
+	var file profileFile
+	if err := yaml.UnmarshalStrict(data, &file); err != nil {
+		return Profile{}, fmt.Errorf("parsing profiles in %s: %w", path, err)
+	}
+	if name == "" {
+		name = file.CurrentProfile
+	}
+	if name == "" {
+		return Profile{}, nil
+	}
+	profile, ok := file.Profiles[name]
+	if !ok {
+		return Profile{}, fmt.Errorf("profile '%s' is not defined in %s", name, path)
+	}
+	return profile, nil
+}
+
+// resolve combines the flags, the environment and the profile, in that order of precedence.
+func (opts *globalOptions) resolve() (Profile, error) {
+	profileName := opts.profile
+	if profileName == "" {
+		profileName = os.Getenv("QUASAR_PROFILE")
+	}
+	profile, err := loadProfile(profileName)
+	if err != nil {
+		return Profile{}, err
+	}
+	if value := firstNonEmpty(opts.server, os.Getenv("QUASAR_SERVER")); value != "" {
+		profile.Server = value
+	}
+	if profile.Server == "" {
+		profile.Server = defaultServer
+	}
+	if value := firstNonEmpty(opts.token, os.Getenv("QUASAR_TOKEN")); value != "" {
+		profile.Token = value
+	}
+	if profile.Token == "" && profile.TokenFile != "" {
+		token, err := os.ReadFile(profile.TokenFile)
+		if err != nil {
+			return Profile{}, fmt.Errorf("reading token: %w", err)
+		}
+		profile.Token = strings.TrimSpace(string(token))
+	}
+	if opts.tls {
+		profile.TLS.Enabled = true
+	}
+	if opts.caFile != "" {
+		profile.TLS.CAFile = opts.caFile
+	}
+	if opts.certFile != "" {
+		profile.TLS.CertFile = opts.certFile
+		profile.TLS.KeyFile = opts.keyFile
+	}
+	if opts.serverName != "" {
+		profile.TLS.ServerName = opts.serverName
+	}
+	if profile.TLS.CAFile != "" || profile.TLS.CertFile != "" {
+		profile.TLS.Enabled = true
+	}
+	return profile, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
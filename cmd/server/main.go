@@ -1,46 +1,287 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"net"
+	"net/http"
 	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
 	meridian_api "github.com/c12s/meridian/pkg/api"
 	oortapi "github.com/c12s/oort/pkg/api"
+	"github.com/jtomic1/config-schema-service/internal/certs"
+	"github.com/jtomic1/config-schema-service/internal/config"
 	"github.com/jtomic1/config-schema-service/internal/configschema"
+	"github.com/jtomic1/config-schema-service/internal/gateway"
+	"github.com/jtomic1/config-schema-service/internal/logging"
+	"github.com/jtomic1/config-schema-service/internal/metrics"
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	"github.com/jtomic1/config-schema-service/internal/search"
 	"github.com/jtomic1/config-schema-service/internal/services"
+	"github.com/jtomic1/config-schema-service/internal/tracing"
+	"github.com/jtomic1/config-schema-service/internal/web"
 	pb "github.com/jtomic1/config-schema-service/proto"
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
+const (
+	oortGarbageCollectionInterval = time.Hour
+	tombstonePurgeInterval        = time.Hour
+	healthCheckInterval           = 5 * time.Second
+)
+
+// fatal logs the error and stops the server.
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
+}
+
 func main() {
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", os.Getenv("SERVER_PORT")))
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
+		fatal("invalid configuration", err)
+	}
+	// the level has already been validated
+	level, _ := logging.ParseLevel(cfg.Logging.Level)
+	slog.SetDefault(logging.New(os.Stdout, level))
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing.Exporter)
+	if err != nil {
+		fatal("failed to set up tracing", err)
+	}
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Server.Port))
 	if err != nil {
-		log.Fatalf("Failed to listen: %v", err)
+		fatal("failed to listen", err)
 	}
+	metricsServer := metrics.NewServer(fmt.Sprintf(":%d", cfg.Server.MetricsPort))
+	go func() {
+		if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fatal("failed to serve metrics", err)
+		}
+	}()
 
-	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(configschema.GetAuthInterceptor()))
+	administrator, err := oortapi.NewAdministrationAsyncClient(cfg.Nats.Address)
+	if err != nil {
+		fatal("failed to create oort client", err)
+	}
+	repoClient, err := repository.NewClient(cfg.Etcd)
+	if err != nil {
+		fatal("failed to connect to etcd", err)
+	}
+	outbox := services.NewOutboxWorker(repoClient)
+	oortDelivery := services.NewOortDelivery(administrator, repoClient)
+	oortDelivery.Register(outbox)
+	natsTLS, err := certs.ClientConfig(cfg.Nats.TLS)
+	if err != nil {
+		fatal("failed to load NATS TLS configuration", err)
+	}
+	var natsOptions []nats.Option
+	if natsTLS != nil {
+		natsOptions = append(natsOptions, nats.Secure(natsTLS))
+	}
+	natsConn, err := nats.Connect(cfg.Nats.Address, natsOptions...)
+	if err != nil {
+		fatal("failed to connect to NATS", err)
+	}
+	services.NewSchemaEventPublisher(natsConn).Register(outbox)
+	// background workers are stopped once the server has drained its requests
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	var workers sync.WaitGroup
+	runWorker := func(run func(ctx context.Context)) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			run(workersCtx)
+		}()
+	}
+	runWorker(outbox.Run)
+	runWorker(func(ctx context.Context) {
+		oortDelivery.RunGarbageCollection(ctx, oortGarbageCollectionInterval, outbox)
+	})
+	runWorker(func(ctx context.Context) {
+		services.NewTombstonePurger(repoClient).Run(ctx, tombstonePurgeInterval)
+	})
 
-	administrator, err := oortapi.NewAdministrationAsyncClient(os.Getenv("NATS_ADDRESS"))
+	authorizer := services.NewAuthZService(cfg.Auth)
+	auditor, err := services.NewAuditLogger(repoClient, cfg.Audit.File)
 	if err != nil {
-		log.Fatalln(err)
+		fatal("failed to open audit log file", err)
+	}
+	// the interceptors are shared by the public server and the in-memory server behind the REST gateway
+	interceptors := []grpc.ServerOption{
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(
+			configschema.GetMetricsInterceptor(),
+			configschema.GetAuthInterceptor(),
+			configschema.GetLoggingInterceptor(authorizer),
+			configschema.GetAuditInterceptor(auditor, authorizer),
+		),
+		grpc.ChainStreamInterceptor(
+			configschema.GetStreamMetricsInterceptor(),
+			configschema.GetStreamAuthInterceptor(),
+			configschema.GetStreamLoggingInterceptor(authorizer),
+			configschema.GetStreamAuditInterceptor(auditor, authorizer),
+		),
+	}
+	serverOptions := append([]grpc.ServerOption{}, interceptors...)
+	var serverTLS *tls.Config
+	if cfg.Server.TLS.Enabled() {
+		serverTLS, err = certs.ServerConfig(cfg.Server.TLS)
+		if err != nil {
+			fatal("failed to load server TLS configuration", err)
+		}
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(serverTLS)))
 	}
-	authorizer := services.NewAuthZService(os.Getenv("SECRET_KEY"))
-	conn, err := grpc.NewClient(os.Getenv("MERIDIAN_ADDRESS"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	grpcServer := grpc.NewServer(serverOptions...)
+	meridianCreds := insecure.NewCredentials()
+	meridianTLS, err := certs.ClientConfig(cfg.Meridian.TLS)
 	if err != nil {
-		log.Fatalln(err)
+		fatal("failed to load meridian TLS configuration", err)
+	}
+	if meridianTLS != nil {
+		meridianCreds = credentials.NewTLS(meridianTLS)
+	}
+	conn, err := grpc.NewClient(cfg.Meridian.Address, grpc.WithTransportCredentials(meridianCreds), grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
+	if err != nil {
+		fatal("failed to create meridian client", err)
 	}
 	meridian := meridian_api.NewMeridianClient(conn)
-	configSchemaServer := configschema.NewServer(authorizer, administrator, meridian)
+	index := search.NewIndex()
+	runWorker(func(ctx context.Context) {
+		index.Sync(ctx, repoClient)
+	})
+	configSchemaServer := configschema.NewServer(authorizer, outbox, meridian, index, cfg)
+	if cfg.Sync.Enabled() {
+		runWorker(configschema.NewSchemaSyncer(configSchemaServer, cfg.Sync).Run)
+		slog.Info("syncing schemas from git", "directory", cfg.Sync.Directory, "interval", time.Duration(cfg.Sync.Interval), "dry_run", cfg.Sync.DryRun)
+	}
 
 	pb.RegisterConfigSchemaServiceServer(grpcServer, configSchemaServer)
+	healthServer := health.NewServer()
+	healthMonitor := services.NewHealthMonitor(healthServer, pb.ConfigSchemaService_ServiceDesc.ServiceName)
+	healthMonitor.AddDependency("etcd", services.EtcdCheck(repoClient))
+	healthMonitor.AddDependency("nats", services.NatsCheck(natsConn))
+	healthMonitor.AddDependency("meridian", services.GrpcConnCheck(conn))
+	runWorker(func(ctx context.Context) {
+		healthMonitor.Run(ctx, healthCheckInterval)
+	})
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
 	reflection.Register(grpcServer)
 
-	log.Printf("Server listening at %v", lis.Addr())
-	if err := grpcServer.Serve(lis); err != nil {
-		log.Fatalf("Failed to serve: %v", err)
+	signalCtx, stopSignals := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stopSignals()
+	serveErr := make(chan error, 3)
+	go func() {
+		serveErr <- grpcServer.Serve(lis)
+	}()
+	slog.Info("server listening", "address", lis.Addr().String(), "tls", cfg.Server.TLS.Enabled(), "client_certificates", cfg.Server.TLS.ClientCAFile != "")
+	var restGateway *gateway.Gateway
+	if cfg.Server.GatewayPort != 0 {
+		restGateway, err = gateway.New(fmt.Sprintf(":%d", cfg.Server.GatewayPort), serverTLS, cfg.CORS, configSchemaServer, interceptors...)
+		if err != nil {
+			fatal("failed to create REST gateway", err)
+		}
+		go func() {
+			if err := restGateway.Serve(); err != nil {
+				serveErr <- err
+			}
+		}()
+		slog.Info("REST gateway listening", "port", cfg.Server.GatewayPort)
+	}
+	var webServer *http.Server
+	if cfg.Server.WebPort != 0 {
+		webServer, err = web.NewServer(fmt.Sprintf(":%d", cfg.Server.WebPort), serverTLS, grpcServer, cfg.CORS)
+		if err != nil {
+			fatal("failed to create gRPC-Web and Connect server", err)
+		}
+		go func() {
+			var err error
+			if webServer.TLSConfig != nil {
+				err = webServer.ListenAndServeTLS("", "")
+			} else {
+				err = webServer.ListenAndServe()
+			}
+			if !errors.Is(err, http.ErrServerClosed) {
+				serveErr <- err
+			}
+		}()
+		slog.Info("gRPC-Web and Connect listening", "port", cfg.Server.WebPort)
+	}
+	select {
+	case err := <-serveErr:
+		fatal("failed to serve", err)
+	case <-signalCtx.Done():
+	}
+
+	slog.Info("shutting down")
+	shutdownTimeout := time.Duration(cfg.Server.ShutdownTimeout)
+	// probes see the server as unavailable while in-flight requests are drained
+	healthMonitor.Shutdown()
+	configSchemaServer.Shutdown()
+	drainCtx, cancelDrain := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancelDrain()
+	stopped := make(chan struct{})
+	go func() {
+		if restGateway != nil {
+			if err := restGateway.Shutdown(drainCtx); err != nil {
+				slog.Warn("REST requests were not drained in time", "error", err)
+			}
+		}
+		// browser calls are handled by the gRPC server, so they are drained before it stops
+		if webServer != nil {
+			if err := webServer.Shutdown(drainCtx); err != nil {
+				slog.Warn("gRPC-Web and Connect requests were not drained in time", "error", err)
+			}
+		}
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-drainCtx.Done():
+		slog.Warn("requests were not drained in time, closing remaining connections", "timeout", shutdownTimeout)
+		grpcServer.Stop()
+	}
+
+	stopWorkers()
+	workers.Wait()
+	flushCtx, cancelFlush := context.WithTimeout(context.Background(), shutdownTimeout)
+	outbox.Flush(flushCtx)
+	cancelFlush()
+
+	closeCtx, cancelClose := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancelClose()
+	if err := auditor.Close(); err != nil {
+		slog.Error("failed to close audit log file", "error", err)
+	}
+	repoClient.Close()
+	// the oort client has its own NATS connection, which is no longer needed once the outbox has been flushed
+	administrator.Close()
+	if err := natsConn.Drain(); err != nil {
+		slog.Error("failed to drain NATS connection", "error", err)
+	}
+	if err := conn.Close(); err != nil {
+		slog.Error("failed to close meridian connection", "error", err)
+	}
+	if err := metricsServer.Shutdown(closeCtx); err != nil {
+		slog.Error("failed to stop metrics server", "error", err)
+	}
+	if err := shutdownTracing(closeCtx); err != nil {
+		slog.Error("failed to flush traces", "error", err)
 	}
+	slog.Info("server stopped")
 }
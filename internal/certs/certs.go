@@ -0,0 +1,215 @@
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/jtomic1/config-schema-service/internal/config"
+)
+
+// KeyPair is a certificate and its private key, which are read again whenever either file changes on disk,
+// so that renewed certificates are used by new connections without restarting the server.
+type KeyPair struct {
+	certFile string
+	keyFile  string
+	mu       sync.Mutex
+	cert     *tls.Certificate
+	modTime  time.Time
+}
+
+// CertPool is a CA bundle which is read again whenever the file changes on disk.
+type CertPool struct {
+	file    string
+	mu      sync.Mutex
+	pool    *x509.CertPool
+	modTime time.Time
+}
+
+// the same files are shared by every client of a dependency, e.g. the etcd client of each request
+var (
+	cacheMu   sync.Mutex
+	keyPairs  = make(map[string]*KeyPair)
+	certPools = make(map[string]*CertPool)
+)
+
+func LoadKeyPair(certFile string, keyFile string) (*KeyPair, error) {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	if kp, ok := keyPairs[certFile+"\x00"+keyFile]; ok {
+		return kp, nil
+	}
+	kp := &KeyPair{certFile: certFile, keyFile: keyFile}
+	if err := kp.reload(); err != nil {
+		return nil, err
+	}
+	keyPairs[certFile+"\x00"+keyFile] = kp
+	return kp, nil
+}
+
+func LoadCertPool(file string) (*CertPool, error) {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	if pool, ok := certPools[file]; ok {
+		return pool, nil
+	}
+	pool := &CertPool{file: file}
+	if err := pool.reload(); err != nil {
+		return nil, err
+	}
+	certPools[file] = pool
+	return pool, nil
+}
+
+// latestModTime returns the time of the most recent change of the files.
+func latestModTime(files ...string) (time.Time, error) {
+	var latest time.Time
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
+
+func (kp *KeyPair) reload() error {
+	modTime, err := latestModTime(kp.certFile, kp.keyFile)
+	if err != nil {
+		return err
+	}
+	if kp.cert != nil && modTime.Equal(kp.modTime) {
+		return nil
+	}
+	cert, err := tls.LoadX509KeyPair(kp.certFile, kp.keyFile)
+	if err != nil {
+		return fmt.Errorf("loading certificate %s: %w", kp.certFile, err)
+	}
+	if kp.cert != nil {
+		slog.Info("reloaded certificate", "file", kp.certFile)
+	}
+	kp.cert = &cert
+	kp.modTime = modTime
+	return nil
+}
+
+// Certificate returns the current certificate. If the files have changed but cannot be read, e.g. because
+// only one of them has been replaced so far, the previous certificate is kept.
+func (kp *KeyPair) Certificate() *tls.Certificate {
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+	if err := kp.reload(); err != nil {
+		slog.Warn("failed to reload certificate, using the previous one", "file", kp.certFile, "error", err)
+	}
+	return kp.cert
+}
+
+func (pool *CertPool) reload() error {
+	modTime, err := latestModTime(pool.file)
+	if err != nil {
+		return err
+	}
+	if pool.pool != nil && modTime.Equal(pool.modTime) {
+		return nil
+	}
+	pem, err := os.ReadFile(pool.file)
+	if err != nil {
+		return err
+	}
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(pem) {
+		return fmt.Errorf("no certificates found in %s", pool.file)
+	}
+	if pool.pool != nil {
+		slog.Info("reloaded CA bundle", "file", pool.file)
+	}
+	pool.pool = certPool
+	pool.modTime = modTime
+	return nil
+}
+
+// Pool returns the current CA bundle, keeping the previous one if the file cannot be read.
+func (pool *CertPool) Pool() *x509.CertPool {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	if err := pool.reload(); err != nil {
+		slog.Warn("failed to reload CA bundle, using the previous one", "file", pool.file, "error", err)
+	}
+	return pool.pool
+}
+
+// ServerConfig returns the TLS configuration of the gRPC listener. The certificate and the client CA are
+// checked for changes on every handshake.
+func ServerConfig(cfg config.ServerTLSConfig) (*tls.Config, error) {
+	if !cfg.Enabled() {
+		return nil, errors.New("server TLS is not configured")
+	}
+	kp, err := LoadKeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	tlsCfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return kp.Certificate(), nil
+		},
+	}
+	if cfg.ClientCAFile == "" {
+		return tlsCfg, nil
+	}
+	clientCAs, err := LoadCertPool(cfg.ClientCAFile)
+	if err != nil {
+		return nil, err
+	}
+	clientAuth := tls.VerifyClientCertIfGiven
+	if cfg.RequireClientCert {
+		clientAuth = tls.RequireAndVerifyClientCert
+	}
+	tlsCfg.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		connCfg := tlsCfg.Clone()
+		connCfg.GetConfigForClient = nil
+		// the configuration returned here replaces the one to which gRPC and net/http add their protocols
+		connCfg.NextProtos = []string{"h2", "http/1.1"}
+		connCfg.ClientAuth = clientAuth
+		connCfg.ClientCAs = clientCAs.Pool()
+		return connCfg, nil
+	}
+	return tlsCfg, nil
+}
+
+// ClientConfig returns the TLS configuration of a connection to a dependency, or nil if TLS is disabled.
+// The client certificate is checked for changes on every handshake, and the CA when the configuration is created.
+func ClientConfig(cfg config.ClientTLSConfig) (*tls.Config, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	tlsCfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: cfg.ServerName,
+	}
+	if cfg.CAFile != "" {
+		rootCAs, err := LoadCertPool(cfg.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsCfg.RootCAs = rootCAs.Pool()
+	}
+	if cfg.CertFile != "" {
+		kp, err := LoadKeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsCfg.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return kp.Certificate(), nil
+		}
+	}
+	return tlsCfg, nil
+}
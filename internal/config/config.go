@@ -0,0 +1,442 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jtomic1/config-schema-service/internal/logging"
+	"github.com/jtomic1/config-schema-service/internal/tracing"
+	"sigs.k8s.io/yaml"
+)
+
+// Config holds every setting of the server. It is loaded from a YAML file, environment variables and flags,
+// in that order of precedence from lowest to highest, on top of the defaults.
+type Config struct {
+	Server   ServerConfig   `json:"server"`
+	Etcd     EtcdConfig     `json:"etcd"`
+	Nats     NatsConfig     `json:"nats"`
+	Meridian MeridianConfig `json:"meridian"`
+	Auth     AuthConfig     `json:"auth"`
+	Schemas  SchemasConfig  `json:"schemas"`
+	Audit    AuditConfig    `json:"audit"`
+	Logging  LoggingConfig  `json:"logging"`
+	Tracing  TracingConfig  `json:"tracing"`
+	CORS     CORSConfig     `json:"cors"`
+	Sync     SyncConfig     `json:"sync"`
+}
+
+type ServerConfig struct {
+	Port        int `json:"port"`
+	MetricsPort int `json:"metricsPort"`
+	// GatewayPort serves the REST API. 0 disables the gateway.
+	GatewayPort int `json:"gatewayPort"`
+	// WebPort serves gRPC-Web and the Connect protocol for browser clients. 0 disables it.
+	WebPort         int             `json:"webPort"`
+	ShutdownTimeout Duration        `json:"shutdownTimeout"`
+	TLS             ServerTLSConfig `json:"tls"`
+}
+
+// ServerTLSConfig enables TLS on the gRPC listener when a certificate is given. Client certificates are
+// verified against the client CA, which turns on mutual TLS.
+type ServerTLSConfig struct {
+	CertFile     string `json:"certFile"`
+	KeyFile      string `json:"keyFile"`
+	ClientCAFile string `json:"clientCAFile"`
+	// RequireClientCert rejects connections without a verified client certificate.
+	RequireClientCert bool `json:"requireClientCert"`
+}
+
+func (cfg ServerTLSConfig) Enabled() bool {
+	return cfg.CertFile != ""
+}
+
+// ClientTLSConfig configures TLS towards a dependency. Servers are verified against the CA, or against the
+// system roots if no CA is given. The certificate is presented to servers which require mutual TLS.
+type ClientTLSConfig struct {
+	Enabled    bool   `json:"enabled"`
+	CAFile     string `json:"caFile"`
+	CertFile   string `json:"certFile"`
+	KeyFile    string `json:"keyFile"`
+	ServerName string `json:"serverName"`
+}
+
+type EtcdConfig struct {
+	Address     string   `json:"address"`
+	DialTimeout Duration `json:"dialTimeout"`
+	// RequestTimeout bounds every repository method.
+	RequestTimeout Duration        `json:"requestTimeout"`
+	TLS            ClientTLSConfig `json:"tls"`
+}
+
+type NatsConfig struct {
+	Address string          `json:"address"`
+	TLS     ClientTLSConfig `json:"tls"`
+}
+
+type MeridianConfig struct {
+	Address string          `json:"address"`
+	TLS     ClientTLSConfig `json:"tls"`
+}
+
+type AuthConfig struct {
+	// SecretKey verifies the signatures of the tokens.
+	SecretKey string `json:"secretKey"`
+	// CertificatePermissions grants permissions to callers which present a verified client certificate
+	// instead of a token. The keys are certificate identities and the values are permissions in the same
+	// "permission|kind|id" format as the permissions claim of the tokens.
+	CertificatePermissions map[string][]string `json:"certificatePermissions"`
+}
+
+type SchemasConfig struct {
+	// DeletedRetention is how long deleted schemas can be restored.
+	DeletedRetention Duration `json:"deletedRetention"`
+}
+
+type AuditConfig struct {
+	// File receives a copy of the audit log as JSON lines. Empty disables the file sink.
+	File string `json:"file"`
+}
+
+type LoggingConfig struct {
+	Level string `json:"level"`
+}
+
+type TracingConfig struct {
+	Exporter string `json:"exporter"`
+}
+
+// CORSConfig lets scripts served from other origins call the web and REST listeners.
+type CORSConfig struct {
+	// AllowedOrigins are origins such as "https://portal.example.org", or "*" for any origin.
+	// Cross-origin requests are refused if it is empty.
+	AllowedOrigins []string `json:"allowedOrigins"`
+	MaxAge         Duration `json:"maxAge"`
+}
+
+// SyncConfig mirrors a working tree of a git repository, laid out as org/namespace/name/version.yaml, into
+// etcd. Keeping the tree up to date, for example with a git-sync sidecar, is left to the deployment.
+type SyncConfig struct {
+	// Directory is the root of the working tree. Empty disables the sync.
+	Directory string   `json:"directory"`
+	Interval  Duration `json:"interval"`
+	// DryRun only logs what the sync would save, without saving it.
+	DryRun bool `json:"dryRun"`
+	// CreatedBy is recorded as the creator of the versions saved by the sync.
+	CreatedBy string `json:"createdBy"`
+}
+
+func (cfg SyncConfig) Enabled() bool {
+	return cfg.Directory != ""
+}
+
+// Duration is a time.Duration which is written as a string such as "30s" in the configuration file.
+type Duration time.Duration
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("duration must be a string such as \"30s\": %w", err)
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return err
+	}
+	*d = Duration(duration)
+	return nil
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func Default() *Config {
+	return &Config{
+		Server: ServerConfig{
+			Port:            50051,
+			MetricsPort:     9090,
+			GatewayPort:     8080,
+			WebPort:         8081,
+			ShutdownTimeout: Duration(30 * time.Second),
+		},
+		Etcd: EtcdConfig{
+			Address:        "localhost:2379",
+			DialTimeout:    Duration(5 * time.Second),
+			RequestTimeout: Duration(5 * time.Second),
+		},
+		Schemas: SchemasConfig{
+			DeletedRetention: Duration(30 * 24 * time.Hour),
+		},
+		Logging: LoggingConfig{
+			Level: "INFO",
+		},
+		Tracing: TracingConfig{
+			Exporter: tracing.ExporterNone,
+		},
+		CORS: CORSConfig{
+			MaxAge: Duration(2 * time.Hour),
+		},
+		Sync: SyncConfig{
+			Interval:  Duration(time.Minute),
+			CreatedBy: "git-sync",
+		},
+	}
+}
+
+// setting is a configuration value which can be overridden by an environment variable and a flag.
+type setting struct {
+	env   string
+	flag  string
+	usage string
+	set   func(cfg *Config, value string) error
+}
+
+var settings = []setting{
+	{"SERVER_PORT", "port", "port of the gRPC server", intSetter(func(cfg *Config) *int { return &cfg.Server.Port })},
+	{"METRICS_PORT", "metrics-port", "port of the metrics HTTP server", intSetter(func(cfg *Config) *int { return &cfg.Server.MetricsPort })},
+	{"GATEWAY_PORT", "gateway-port", "port of the REST gateway (0 disables it)", intSetter(func(cfg *Config) *int { return &cfg.Server.GatewayPort })},
+	{"WEB_PORT", "web-port", "port of gRPC-Web and Connect (0 disables it)", intSetter(func(cfg *Config) *int { return &cfg.Server.WebPort })},
+	{"SHUTDOWN_TIMEOUT", "shutdown-timeout", "time given to in-flight calls when the server stops", durationSetter(func(cfg *Config) *Duration { return &cfg.Server.ShutdownTimeout })},
+	{"ETCD_ADDRESS", "etcd-address", "address of etcd", stringSetter(func(cfg *Config) *string { return &cfg.Etcd.Address })},
+	{"ETCD_DIAL_TIMEOUT", "etcd-dial-timeout", "timeout of connecting to etcd", durationSetter(func(cfg *Config) *Duration { return &cfg.Etcd.DialTimeout })},
+	{"ETCD_REQUEST_TIMEOUT", "etcd-request-timeout", "timeout of every repository operation", durationSetter(func(cfg *Config) *Duration { return &cfg.Etcd.RequestTimeout })},
+	{"NATS_ADDRESS", "nats-address", "address of NATS", stringSetter(func(cfg *Config) *string { return &cfg.Nats.Address })},
+	{"MERIDIAN_ADDRESS", "meridian-address", "address of meridian", stringSetter(func(cfg *Config) *string { return &cfg.Meridian.Address })},
+	{"TLS_CERT_FILE", "tls-cert-file", "certificate of the gRPC server, which enables TLS", stringSetter(func(cfg *Config) *string { return &cfg.Server.TLS.CertFile })},
+	{"TLS_KEY_FILE", "tls-key-file", "private key of the gRPC server certificate", stringSetter(func(cfg *Config) *string { return &cfg.Server.TLS.KeyFile })},
+	{"TLS_CLIENT_CA_FILE", "tls-client-ca-file", "CA which verifies client certificates", stringSetter(func(cfg *Config) *string { return &cfg.Server.TLS.ClientCAFile })},
+	{"TLS_REQUIRE_CLIENT_CERT", "tls-require-client-cert", "reject clients without a verified certificate", boolSetter(func(cfg *Config) *bool { return &cfg.Server.TLS.RequireClientCert })},
+	{"SECRET_KEY", "secret-key", "key which verifies token signatures", stringSetter(func(cfg *Config) *string { return &cfg.Auth.SecretKey })},
+	{"DELETED_SCHEMA_RETENTION", "deleted-schema-retention", "how long deleted schemas can be restored", durationSetter(func(cfg *Config) *Duration { return &cfg.Schemas.DeletedRetention })},
+	{"AUDIT_LOG_FILE", "audit-log-file", "file which receives a copy of the audit log", stringSetter(func(cfg *Config) *string { return &cfg.Audit.File })},
+	{"LOG_LEVEL", "log-level", "minimum log level (DEBUG, INFO, WARN or ERROR)", stringSetter(func(cfg *Config) *string { return &cfg.Logging.Level })},
+	{"CORS_ALLOWED_ORIGINS", "cors-allowed-origins", "comma-separated origins which may call the web and REST listeners", listSetter(func(cfg *Config) *[]string { return &cfg.CORS.AllowedOrigins })},
+	{"SYNC_DIRECTORY", "sync-directory", "git working tree which is mirrored into etcd (empty disables the sync)", stringSetter(func(cfg *Config) *string { return &cfg.Sync.Directory })},
+	{"SYNC_INTERVAL", "sync-interval", "interval between syncs of the git working tree", durationSetter(func(cfg *Config) *Duration { return &cfg.Sync.Interval })},
+	{"SYNC_DRY_RUN", "sync-dry-run", "only log what the sync would save", boolSetter(func(cfg *Config) *bool { return &cfg.Sync.DryRun })},
+	{"SYNC_CREATED_BY", "sync-created-by", "creator recorded for versions saved by the sync", stringSetter(func(cfg *Config) *string { return &cfg.Sync.CreatedBy })},
+	{"TRACE_EXPORTER", "trace-exporter", "trace exporter (none, otlp or stdout)", stringSetter(func(cfg *Config) *string { return &cfg.Tracing.Exporter })},
+}
+
+func init() {
+	settings = append(settings, clientTLSSettings("ETCD", "etcd", func(cfg *Config) *ClientTLSConfig { return &cfg.Etcd.TLS })...)
+	settings = append(settings, clientTLSSettings("NATS", "nats", func(cfg *Config) *ClientTLSConfig { return &cfg.Nats.TLS })...)
+	settings = append(settings, clientTLSSettings("MERIDIAN", "meridian", func(cfg *Config) *ClientTLSConfig { return &cfg.Meridian.TLS })...)
+}
+
+// clientTLSSettings returns the settings of the TLS connection to a dependency, such as ETCD_TLS_CA_FILE and -etcd-tls-ca-file.
+func clientTLSSettings(envPrefix string, flagPrefix string, section func(cfg *Config) *ClientTLSConfig) []setting {
+	return []setting{
+		{envPrefix + "_TLS_ENABLED", flagPrefix + "-tls-enabled", "connect to " + flagPrefix + " over TLS", boolSetter(func(cfg *Config) *bool { return &section(cfg).Enabled })},
+		{envPrefix + "_TLS_CA_FILE", flagPrefix + "-tls-ca-file", "CA which verifies " + flagPrefix, stringSetter(func(cfg *Config) *string { return &section(cfg).CAFile })},
+		{envPrefix + "_TLS_CERT_FILE", flagPrefix + "-tls-cert-file", "client certificate presented to " + flagPrefix, stringSetter(func(cfg *Config) *string { return &section(cfg).CertFile })},
+		{envPrefix + "_TLS_KEY_FILE", flagPrefix + "-tls-key-file", "private key of the client certificate presented to " + flagPrefix, stringSetter(func(cfg *Config) *string { return &section(cfg).KeyFile })},
+		{envPrefix + "_TLS_SERVER_NAME", flagPrefix + "-tls-server-name", "name which the certificate of " + flagPrefix + " is verified against", stringSetter(func(cfg *Config) *string { return &section(cfg).ServerName })},
+	}
+}
+
+func stringSetter(field func(cfg *Config) *string) func(cfg *Config, value string) error {
+	return func(cfg *Config, value string) error {
+		*field(cfg) = value
+		return nil
+	}
+}
+
+func intSetter(field func(cfg *Config) *int) func(cfg *Config, value string) error {
+	return func(cfg *Config, value string) error {
+		number, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("'%s' is not a number", value)
+		}
+		*field(cfg) = number
+		return nil
+	}
+}
+
+func listSetter(field func(cfg *Config) *[]string) func(cfg *Config, value string) error {
+	return func(cfg *Config, value string) error {
+		var items []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		*field(cfg) = items
+		return nil
+	}
+}
+
+func boolSetter(field func(cfg *Config) *bool) func(cfg *Config, value string) error {
+	return func(cfg *Config, value string) error {
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("'%s' is not a boolean", value)
+		}
+		*field(cfg) = enabled
+		return nil
+	}
+}
+
+func durationSetter(field func(cfg *Config) *Duration) func(cfg *Config, value string) error {
+	return func(cfg *Config, value string) error {
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		*field(cfg) = Duration(duration)
+		return nil
+	}
+}
+
+// Load builds the configuration from the command line arguments (without the program name). The configuration
+// file is given with the -config flag or the CONFIG_FILE environment variable, and is optional.
+func Load(args []string) (*Config, error) {
+	fs := flag.NewFlagSet("server", flag.ContinueOnError)
+	configFile := fs.String("config", os.Getenv("CONFIG_FILE"), "path of the YAML configuration file")
+	flagValues := make(map[string]*string, len(settings))
+	for _, s := range settings {
+		flagValues[s.flag] = fs.String(s.flag, "", fmt.Sprintf("%s (overrides %s)", s.usage, s.env))
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	cfg := Default()
+	if *configFile != "" {
+		data, err := os.ReadFile(*configFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading configuration file: %w", err)
+		}
+		if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+			return nil, fmt.Errorf("parsing configuration file %s: %w", *configFile, err)
+		}
+	}
+	for _, s := range settings {
+		if value, ok := os.LookupEnv(s.env); ok && value != "" {
+			if err := s.set(cfg, value); err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", s.env, err)
+			}
+		}
+	}
+	var err error
+	fs.Visit(func(f *flag.Flag) {
+		for _, s := range settings {
+			if s.flag == f.Name && err == nil {
+				if setErr := s.set(cfg, *flagValues[s.flag]); setErr != nil {
+					err = fmt.Errorf("invalid -%s: %w", s.flag, setErr)
+				}
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Validate reports every invalid setting at once.
+func (cfg *Config) Validate() error {
+	var errs []error
+	if cfg.Server.Port < 1 || cfg.Server.Port > 65535 {
+		errs = append(errs, fmt.Errorf("server.port must be between 1 and 65535, got %d", cfg.Server.Port))
+	}
+	if cfg.Server.MetricsPort < 1 || cfg.Server.MetricsPort > 65535 {
+		errs = append(errs, fmt.Errorf("server.metricsPort must be between 1 and 65535, got %d", cfg.Server.MetricsPort))
+	} else if cfg.Server.MetricsPort == cfg.Server.Port {
+		errs = append(errs, errors.New("server.metricsPort must differ from server.port"))
+	}
+	if cfg.Server.GatewayPort < 0 || cfg.Server.GatewayPort > 65535 {
+		errs = append(errs, fmt.Errorf("server.gatewayPort must be between 0 and 65535, got %d", cfg.Server.GatewayPort))
+	} else if cfg.Server.GatewayPort == cfg.Server.Port || cfg.Server.GatewayPort == cfg.Server.MetricsPort {
+		errs = append(errs, errors.New("server.gatewayPort must differ from server.port and server.metricsPort"))
+	}
+	if cfg.Server.WebPort < 0 || cfg.Server.WebPort > 65535 {
+		errs = append(errs, fmt.Errorf("server.webPort must be between 0 and 65535, got %d", cfg.Server.WebPort))
+	} else if cfg.Server.WebPort != 0 && (cfg.Server.WebPort == cfg.Server.Port || cfg.Server.WebPort == cfg.Server.MetricsPort || cfg.Server.WebPort == cfg.Server.GatewayPort) {
+		errs = append(errs, errors.New("server.webPort must differ from the other ports"))
+	}
+	if cfg.CORS.MaxAge < 0 {
+		errs = append(errs, errors.New("cors.maxAge cannot be negative"))
+	}
+	if cfg.Server.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("server.shutdownTimeout must be positive"))
+	}
+	errs = append(errs, cfg.Server.TLS.validate("server.tls")...)
+	if cfg.Etcd.Address == "" {
+		errs = append(errs, errors.New("etcd.address is required (ETCD_ADDRESS)"))
+	}
+	if cfg.Etcd.DialTimeout <= 0 || cfg.Etcd.RequestTimeout <= 0 {
+		errs = append(errs, errors.New("etcd.dialTimeout and etcd.requestTimeout must be positive"))
+	}
+	errs = append(errs, cfg.Etcd.TLS.validate("etcd.tls")...)
+	if cfg.Nats.Address == "" {
+		errs = append(errs, errors.New("nats.address is required (NATS_ADDRESS)"))
+	}
+	errs = append(errs, cfg.Nats.TLS.validate("nats.tls")...)
+	if cfg.Meridian.Address == "" {
+		errs = append(errs, errors.New("meridian.address is required (MERIDIAN_ADDRESS)"))
+	}
+	errs = append(errs, cfg.Meridian.TLS.validate("meridian.tls")...)
+	if len(cfg.Auth.CertificatePermissions) > 0 && cfg.Server.TLS.ClientCAFile == "" {
+		errs = append(errs, errors.New("auth.certificatePermissions requires server.tls.clientCAFile"))
+	}
+	if cfg.Auth.SecretKey == "" {
+		errs = append(errs, errors.New("auth.secretKey is required (SECRET_KEY)"))
+	}
+	if cfg.Schemas.DeletedRetention <= 0 {
+		errs = append(errs, errors.New("schemas.deletedRetention must be positive"))
+	}
+	if cfg.Sync.Enabled() {
+		if info, err := os.Stat(cfg.Sync.Directory); err != nil || !info.IsDir() {
+			errs = append(errs, fmt.Errorf("sync.directory '%s' is not a directory", cfg.Sync.Directory))
+		}
+		if cfg.Sync.Interval <= 0 {
+			errs = append(errs, errors.New("sync.interval must be positive"))
+		}
+		if cfg.Sync.CreatedBy == "" {
+			errs = append(errs, errors.New("sync.createdBy cannot be empty"))
+		}
+	}
+	if _, err := logging.ParseLevel(cfg.Logging.Level); err != nil {
+		errs = append(errs, fmt.Errorf("logging.level is invalid: %w", err))
+	}
+	switch cfg.Tracing.Exporter {
+	case tracing.ExporterNone, tracing.ExporterOtlp, tracing.ExporterStdout:
+	default:
+		errs = append(errs, fmt.Errorf("tracing.exporter must be one of %s, %s and %s, got '%s'", tracing.ExporterNone, tracing.ExporterOtlp, tracing.ExporterStdout, cfg.Tracing.Exporter))
+	}
+	return errors.Join(errs...)
+}
+
+func (cfg ServerTLSConfig) validate(section string) []error {
+	var errs []error
+	if (cfg.CertFile == "") != (cfg.KeyFile == "") {
+		errs = append(errs, fmt.Errorf("%s.certFile and %s.keyFile must be given together", section, section))
+	}
+	if cfg.ClientCAFile != "" && !cfg.Enabled() {
+		errs = append(errs, fmt.Errorf("%s.clientCAFile requires %s.certFile", section, section))
+	}
+	if cfg.RequireClientCert && cfg.ClientCAFile == "" {
+		errs = append(errs, fmt.Errorf("%s.requireClientCert requires %s.clientCAFile", section, section))
+	}
+	return errs
+}
+
+func (cfg ClientTLSConfig) validate(section string) []error {
+	var errs []error
+	if (cfg.CertFile == "") != (cfg.KeyFile == "") {
+		errs = append(errs, fmt.Errorf("%s.certFile and %s.keyFile must be given together", section, section))
+	}
+	if !cfg.Enabled && (cfg.CAFile != "" || cfg.CertFile != "") {
+		errs = append(errs, fmt.Errorf("%s.enabled must be set when certificates are given", section))
+	}
+	return errs
+}
@@ -0,0 +1,160 @@
+package configschema
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Every version is archived as a ConfigSchema in JSON, which holds its metadata and creation time. NDJSON
+// archives hold one version per line, and tar and zip archives one file per version, named after its key.
+var (
+	archiveLineOptions = protojson.MarshalOptions{}
+	archiveFileOptions = protojson.MarshalOptions{Multiline: true, Indent: "  "}
+	archiveReadOptions = protojson.UnmarshalOptions{DiscardUnknown: true}
+)
+
+func archiveFileName(schema *pb.ConfigSchema) string {
+	return getConfigSchemaKey(schema.GetSchemaDetails()) + ".json"
+}
+
+func writeArchive(w io.Writer, format pb.ArchiveFormat, schemas []*pb.ConfigSchema) error {
+	switch format {
+	case pb.ArchiveFormat_NDJSON:
+		for _, schema := range schemas {
+			line, err := archiveLineOptions.Marshal(schema)
+			if err != nil {
+				return err
+			}
+			if _, err := w.Write(append(line, '\n')); err != nil {
+				return err
+			}
+		}
+		return nil
+	case pb.ArchiveFormat_TAR:
+		tw := tar.NewWriter(w)
+		for _, schema := range schemas {
+			file, err := archiveFileOptions.Marshal(schema)
+			if err != nil {
+				return err
+			}
+			err = tw.WriteHeader(&tar.Header{
+				Typeflag: tar.TypeReg,
+				Name:     archiveFileName(schema),
+				Mode:     0644,
+				Size:     int64(len(file)),
+				ModTime:  schema.GetSchemaData().GetCreationTime().AsTime(),
+			})
+			if err != nil {
+				return err
+			}
+			if _, err := tw.Write(file); err != nil {
+				return err
+			}
+		}
+		return tw.Close()
+	case pb.ArchiveFormat_ZIP:
+		zw := zip.NewWriter(w)
+		for _, schema := range schemas {
+			file, err := archiveFileOptions.Marshal(schema)
+			if err != nil {
+				return err
+			}
+			fw, err := zw.CreateHeader(&zip.FileHeader{
+				Name:     archiveFileName(schema),
+				Method:   zip.Deflate,
+				Modified: schema.GetSchemaData().GetCreationTime().AsTime(),
+			})
+			if err != nil {
+				return err
+			}
+			if _, err := fw.Write(file); err != nil {
+				return err
+			}
+		}
+		return zw.Close()
+	default:
+		return errors.New("unknown archive format")
+	}
+}
+
+// readArchive returns the versions of an archive in the order in which they are archived.
+func readArchive(format pb.ArchiveFormat, data []byte) ([]*pb.ConfigSchema, error) {
+	var schemas []*pb.ConfigSchema
+	read := func(name string, file []byte) error {
+		schema := &pb.ConfigSchema{}
+		if err := archiveReadOptions.Unmarshal(file, schema); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		schemas = append(schemas, schema)
+		return nil
+	}
+	switch format {
+	case pb.ArchiveFormat_NDJSON:
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		scanner.Buffer(nil, len(data)+1)
+		for line := 1; scanner.Scan(); line++ {
+			if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+				continue
+			}
+			if err := read(fmt.Sprintf("line %d", line), scanner.Bytes()); err != nil {
+				return nil, err
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	case pb.ArchiveFormat_TAR:
+		tr := tar.NewReader(bytes.NewReader(data))
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, err
+			}
+			if header.Typeflag != tar.TypeReg {
+				continue
+			}
+			file, err := io.ReadAll(tr)
+			if err != nil {
+				return nil, err
+			}
+			if err := read(header.Name, file); err != nil {
+				return nil, err
+			}
+		}
+	case pb.ArchiveFormat_ZIP:
+		zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range zr.File {
+			if entry.FileInfo().IsDir() {
+				continue
+			}
+			fr, err := entry.Open()
+			if err != nil {
+				return nil, err
+			}
+			file, err := io.ReadAll(fr)
+			fr.Close()
+			if err != nil {
+				return nil, err
+			}
+			if err := read(entry.Name, file); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, errors.New("unknown archive format")
+	}
+	return schemas, nil
+}
@@ -0,0 +1,184 @@
+package configschema
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jtomic1/config-schema-service/internal/logging"
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	"github.com/jtomic1/config-schema-service/internal/services"
+	"github.com/jtomic1/config-schema-service/internal/validators"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultAuditPageSize = 100
+	maxAuditPageSize     = 1000
+)
+
+func (s *Server) QueryAuditLog(ctx context.Context, in *pb.QueryAuditLogRequest) (*pb.QueryAuditLogResponse, error) {
+	if !s.authorizeScope(ctx, services.PermAuditGet, in.GetOrganization(), in.GetNamespace()) {
+		return nil, fmt.Errorf("permission denied: %s", services.PermAuditGet)
+	}
+	_, err := validators.IsQueryAuditLogRequestValid(in)
+	if err != nil {
+		return &pb.QueryAuditLogResponse{
+			Status:  3,
+			Message: err.Error(),
+		}, nil
+	}
+	startAfter, err := decodePageToken(in.GetPageToken(), repository.AuditKeyPrefix(in.GetOrganization()))
+	if err != nil {
+		return &pb.QueryAuditLogResponse{
+			Status:  3,
+			Message: err.Error(),
+		}, nil
+	}
+	pageSize := int(in.GetPageSize())
+	if pageSize == 0 {
+		pageSize = defaultAuditPageSize
+	} else if pageSize > maxAuditPageSize {
+		pageSize = maxAuditPageSize
+	}
+	repoClient, err := repository.NewClient(s.etcd)
+	if err != nil {
+		return &pb.QueryAuditLogResponse{
+			Status:  13,
+			Message: "Error while instantiating database client!",
+		}, nil
+	}
+	defer repoClient.Close()
+
+	scope := requestScope(in)
+	entries, lastKey, err := repoClient.QueryAuditLog(ctx, in.GetOrganization(), optionalTime(in.GetStartTime()), optionalTime(in.GetEndTime()), startAfter, pageSize, func(entry *pb.AuditLogEntry) bool {
+		return isInScope(entry.GetSchemaKey(), scope) && (in.GetActor() == "" || entry.GetSubject() == in.GetActor())
+	})
+	if err != nil {
+		return &pb.QueryAuditLogResponse{
+			Status:  13,
+			Message: "Error while querying audit log!",
+		}, nil
+	}
+	var message string
+	if len(entries) == 0 {
+		message = "No audit entries found for '" + scope + "'!"
+	} else {
+		message = "Audit entries retrieved successfully!"
+	}
+	return &pb.QueryAuditLogResponse{
+		Status:        0,
+		Message:       message,
+		Entries:       entries,
+		NextPageToken: encodePageToken(lastKey),
+	}, nil
+}
+
+// GetAuditInterceptor records every call in the audit log. It must follow the interceptor which assigns the request ID.
+func GetAuditInterceptor(auditor *services.AuditLogger, authorizer *services.AuthZService) func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !isConfigSchemaMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		resp, err := handler(ctx, req)
+		auditor.Record(ctx, newAuditEntry(ctx, authorizer, info.FullMethod, req, resp, err))
+		return resp, err
+	}
+}
+
+// GetStreamAuditInterceptor records every stream in the audit log once it ends.
+func GetStreamAuditInterceptor(auditor *services.AuditLogger, authorizer *services.AuthZService) func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !isConfigSchemaMethod(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		stream := &recordingStream{ServerStream: ss, ctx: ss.Context()}
+		err := handler(srv, stream)
+		auditor.Record(ss.Context(), newAuditEntry(ss.Context(), authorizer, info.FullMethod, stream.req, nil, err))
+		return err
+	}
+}
+
+func newAuditEntry(ctx context.Context, authorizer *services.AuthZService, rpc string, req interface{}, resp interface{}, err error) *pb.AuditLogEntry {
+	subject, _ := authorizer.Subject(ctx)
+	code, message := callOutcome(resp, err)
+	return &pb.AuditLogEntry{
+		RequestId: logging.RequestId(ctx),
+		Time:      timestamppb.Now(),
+		Subject:   subject,
+		Rpc:       rpc,
+		SchemaKey: requestScope(req),
+		Status:    code,
+		Message:   message,
+	}
+}
+
+// callOutcome returns the status code and the message of a response, or of the error returned instead of it.
+func callOutcome(resp interface{}, err error) (int32, string) {
+	if err != nil {
+		code := int32(status.Code(err))
+		// handlers deny access with plain errors, which would otherwise be reported as unknown
+		if code == int32(codes.Unknown) && strings.HasPrefix(err.Error(), "permission denied") {
+			code = int32(codes.PermissionDenied)
+		}
+		return code, err.Error()
+	}
+	if result, ok := resp.(interface {
+		GetStatus() int32
+		GetMessage() string
+	}); ok {
+		return result.GetStatus(), result.GetMessage()
+	}
+	return 0, ""
+}
+
+// requestScope returns the key of the schema which a request targets, or the key prefix of the targeted schemas
+// without the trailing separator, e.g. "org/ns" for a request which targets a whole namespace.
+func requestScope(req interface{}) string {
+	return strings.Join(requestSegments(req), "/")
+}
+
+// requestSegments returns the organization, namespace, schema name and version targeted by a request,
+// up to the first one which is not given.
+func requestSegments(req interface{}) []string {
+	if r, ok := req.(interface {
+		GetSchemaDetails() *pb.ConfigSchemaDetails
+	}); ok {
+		req = r.GetSchemaDetails()
+	}
+	var segments []string
+	if r, ok := req.(interface{ GetOrganization() string }); ok {
+		segments = append(segments, r.GetOrganization())
+	}
+	if r, ok := req.(interface{ GetNamespace() string }); ok {
+		segments = append(segments, r.GetNamespace())
+	}
+	if r, ok := req.(interface{ GetSchemaName() string }); ok {
+		segments = append(segments, r.GetSchemaName())
+	}
+	if r, ok := req.(interface{ GetVersion() string }); ok {
+		segments = append(segments, r.GetVersion())
+	}
+	for i, segment := range segments {
+		if segment == "" {
+			return segments[:i]
+		}
+	}
+	return segments
+}
+
+func isInScope(key string, scope string) bool {
+	return key == scope || strings.HasPrefix(key, scope+"/")
+}
+
+func optionalTime(timestamp *timestamppb.Timestamp) time.Time {
+	if timestamp == nil {
+		return time.Time{}
+	}
+	return timestamp.AsTime()
+}
@@ -2,27 +2,43 @@ package configschema
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
-	"log"
+	"sort"
+	"sync"
+	"time"
 
 	meridian_api "github.com/c12s/meridian/pkg/api"
-	oortapi "github.com/c12s/oort/pkg/api"
+	"github.com/jtomic1/config-schema-service/internal/config"
+	"github.com/jtomic1/config-schema-service/internal/metrics"
 	"github.com/jtomic1/config-schema-service/internal/repository"
+	"github.com/jtomic1/config-schema-service/internal/search"
+	"github.com/jtomic1/config-schema-service/internal/semverrange"
 	"github.com/jtomic1/config-schema-service/internal/services"
 	"github.com/jtomic1/config-schema-service/internal/validators"
+	"github.com/jtomic1/config-schema-service/pkg/validation"
 	pb "github.com/jtomic1/config-schema-service/proto"
-	"github.com/xeipuuv/gojsonschema"
 	"golang.org/x/mod/semver"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
-	"sigs.k8s.io/yaml"
+)
+
+const (
+	defaultVersionsPageSize = 20
+	maxVersionsPageSize     = 100
 )
 
 type Server struct {
 	pb.UnimplementedConfigSchemaServiceServer
-	authorizer    *services.AuthZService
-	administrator *oortapi.AdministrationAsyncClient
-	meridian      meridian_api.MeridianClient
+	authorizer *services.AuthZService
+	outbox     *services.OutboxWorker
+	meridian   meridian_api.MeridianClient
+	index      *search.Index
+	etcd       config.EtcdConfig
+	retention  time.Duration
+	shutdown   chan struct{}
+	stopOnce   sync.Once
 }
 
 type ConfigSchemaRequest interface {
@@ -32,11 +48,32 @@ type ConfigSchemaRequest interface {
 	GetNamespace() string
 }
 
-func NewServer(authorizer *services.AuthZService, administrator *oortapi.AdministrationAsyncClient, meridian meridian_api.MeridianClient) *Server {
+// NewServer creates the service. Deleted schemas can be restored until the configured retention expires.
+func NewServer(authorizer *services.AuthZService, outbox *services.OutboxWorker, meridian meridian_api.MeridianClient, index *search.Index, cfg *config.Config) *Server {
 	return &Server{
-		authorizer:    authorizer,
-		administrator: administrator,
-		meridian:      meridian,
+		authorizer: authorizer,
+		outbox:     outbox,
+		meridian:   meridian,
+		index:      index,
+		etcd:       cfg.Etcd,
+		retention:  time.Duration(cfg.Schemas.DeletedRetention),
+		shutdown:   make(chan struct{}),
+	}
+}
+
+// Shutdown ends open watches. Unary calls are not affected, so that they can be drained.
+func (s *Server) Shutdown() {
+	s.stopOnce.Do(func() {
+		close(s.shutdown)
+	})
+}
+
+func (s *Server) isShuttingDown() bool {
+	select {
+	case <-s.shutdown:
+		return true
+	default:
+		return false
 	}
 }
 
@@ -44,8 +81,29 @@ func getConfigSchemaKey(req ConfigSchemaRequest) string {
 	return req.GetOrganization() + "/" + req.GetNamespace() + "/" + req.GetSchemaName() + "/" + req.GetVersion()
 }
 
+// getConfigSchemaPrefix ends with a separator, so that the prefix of a schema does not match
+// other schemas whose names start the same way.
 func getConfigSchemaPrefix(req ConfigSchemaRequest) string {
-	return req.GetOrganization() + "/" + req.GetNamespace() + "/" + req.GetSchemaName()
+	return req.GetOrganization() + "/" + req.GetNamespace() + "/" + req.GetSchemaName() + "/"
+}
+
+// sortBySchemaAndVersion orders versions schema by schema, each in semver order.
+func sortBySchemaAndVersion(schemas []*pb.ConfigSchema) {
+	sort.SliceStable(schemas, func(i, j int) bool {
+		iPrefix, jPrefix := getConfigSchemaPrefix(schemas[i].GetSchemaDetails()), getConfigSchemaPrefix(schemas[j].GetSchemaDetails())
+		if iPrefix != jPrefix {
+			return iPrefix < jPrefix
+		}
+		return semver.Compare(schemas[i].GetSchemaDetails().GetVersion(), schemas[j].GetSchemaDetails().GetVersion()) == -1
+	})
+}
+
+// authorizeScope checks the permission on the namespace if one is given, and on the whole organization otherwise.
+func (s *Server) authorizeScope(ctx context.Context, permName string, org string, namespace string) bool {
+	if namespace == "" {
+		return s.authorizer.Authorize(ctx, permName, services.OortResOrg, org)
+	}
+	return s.authorizer.Authorize(ctx, permName, services.OortResNamespace, fmt.Sprintf("%s/%s", org, namespace))
 }
 
 func (s *Server) SaveConfigSchema(ctx context.Context, in *pb.SaveConfigSchemaRequest) (*pb.SaveConfigSchemaResponse, error) {
@@ -66,7 +124,7 @@ func (s *Server) SaveConfigSchema(ctx context.Context, in *pb.SaveConfigSchemaRe
 			Message: err.Error(),
 		}, nil
 	}
-	repoClient, err := repository.NewClient()
+	repoClient, err := repository.NewClient(s.etcd)
 	if err != nil {
 		return &pb.SaveConfigSchemaResponse{
 			Status:  13,
@@ -75,45 +133,63 @@ func (s *Server) SaveConfigSchema(ctx context.Context, in *pb.SaveConfigSchemaRe
 	}
 	defer repoClient.Close()
 
-	latestVersion, err := repoClient.GetLatestVersionByPrefix(getConfigSchemaPrefix(in.GetSchemaDetails()))
+	// the token has already been verified by the authorizer, so the subject is only missing if the token has none
+	createdBy, _ := s.authorizer.Subject(ctx)
+	return s.saveConfigSchema(ctx, repoClient, in, &pb.ConfigSchemaData{CreatedBy: createdBy}), nil
+}
+
+// saveConfigSchema stores a validated and authorized schema as the latest version. The origin holds the
+// creator, and for imported schemas also the creation time and lifecycle, which are kept.
+func (s *Server) saveConfigSchema(ctx context.Context, repoClient *repository.EtcdRepository, in *pb.SaveConfigSchemaRequest, origin *pb.ConfigSchemaData) *pb.SaveConfigSchemaResponse {
+	latestVersion, err := repoClient.GetLatestVersionByPrefix(ctx, getConfigSchemaPrefix(in.GetSchemaDetails()))
 	if err != nil {
 		return &pb.SaveConfigSchemaResponse{
 			Status:  13,
 			Message: err.Error(),
-		}, nil
+		}
 	}
 	if latestVersion != "" && semver.Compare(in.GetSchemaDetails().GetVersion(), latestVersion) != 1 {
 		return &pb.SaveConfigSchemaResponse{
 			Status:  3,
 			Message: "Provided version is not latest! Please provide a version that succeeds '" + latestVersion + "'!",
-		}, nil
+		}
 	}
-	err = repoClient.SaveConfigSchema(getConfigSchemaKey(in.GetSchemaDetails()), in.GetSchema())
+	oortEntry, err := services.NewOortCreateSchemaRelEntry(in.SchemaDetails.Organization, in.SchemaDetails.Namespace, in.SchemaDetails.SchemaName, in.SchemaDetails.Version)
 	if err != nil {
 		return &pb.SaveConfigSchemaResponse{
 			Status:  13,
 			Message: err.Error(),
-		}, nil
+		}
 	}
-	err = s.administrator.SendRequest(&oortapi.CreateInheritanceRelReq{
-		From: &oortapi.Resource{
-			Id:   in.SchemaDetails.Organization,
-			Kind: services.OortResOrg,
-		},
-		To: &oortapi.Resource{
-			Id:   services.OortSchemaId(in.SchemaDetails.Organization, in.SchemaDetails.Namespace, in.SchemaDetails.SchemaName, in.SchemaDetails.Version),
-			Kind: services.OortResSchema,
-		},
-	}, func(resp *oortapi.AdministrationAsyncResp) {
-		log.Println(resp.Error)
-	})
+	eventEntry, err := services.NewSchemaCreatedEntry(in.GetSchemaDetails())
 	if err != nil {
-		log.Println(err)
+		return &pb.SaveConfigSchemaResponse{
+			Status:  13,
+			Message: err.Error(),
+		}
+	}
+	schemaData := &pb.ConfigSchemaData{
+		Schema:       in.GetSchema(),
+		CreationTime: origin.GetCreationTime(),
+		Description:  in.GetDescription(),
+		CreatedBy:    origin.GetCreatedBy(),
+		Labels:       in.GetLabels(),
+		Tags:         in.GetTags(),
+		Lifecycle:    origin.GetLifecycle(),
+		ImportedFrom: origin.GetImportedFrom(),
+	}
+	err = repoClient.SaveConfigSchema(ctx, getConfigSchemaKey(in.GetSchemaDetails()), schemaData, oortEntry, eventEntry)
+	if err != nil {
+		return &pb.SaveConfigSchemaResponse{
+			Status:  13,
+			Message: err.Error(),
+		}
 	}
+	s.outbox.Notify()
 	return &pb.SaveConfigSchemaResponse{
 		Status:  0,
 		Message: "Schema saved successfully!",
-	}, nil
+	}
 }
 
 func (s *Server) GetConfigSchema(ctx context.Context, in *pb.GetConfigSchemaRequest) (*pb.GetConfigSchemaResponse, error) {
@@ -129,7 +205,7 @@ func (s *Server) GetConfigSchema(ctx context.Context, in *pb.GetConfigSchemaRequ
 			SchemaData: nil,
 		}, nil
 	}
-	repoClient, err := repository.NewClient()
+	repoClient, err := repository.NewClient(s.etcd)
 	if err != nil {
 		return &pb.GetConfigSchemaResponse{
 			Status:     13,
@@ -140,7 +216,7 @@ func (s *Server) GetConfigSchema(ctx context.Context, in *pb.GetConfigSchemaRequ
 	defer repoClient.Close()
 
 	key := getConfigSchemaKey(in.GetSchemaDetails())
-	schemaData, err := repoClient.GetConfigSchema(key)
+	schemaData, err := repoClient.GetConfigSchema(ctx, key)
 	if err != nil {
 		return &pb.GetConfigSchemaResponse{
 			Status:     13,
@@ -173,7 +249,7 @@ func (s *Server) DeleteConfigSchema(ctx context.Context, in *pb.DeleteConfigSche
 			Message: err.Error(),
 		}, nil
 	}
-	repoClient, err := repository.NewClient()
+	repoClient, err := repository.NewClient(s.etcd)
 	if err != nil {
 		return &pb.DeleteConfigSchemaResponse{
 			Status:  13,
@@ -182,12 +258,29 @@ func (s *Server) DeleteConfigSchema(ctx context.Context, in *pb.DeleteConfigSche
 	}
 	defer repoClient.Close()
 
-	if err := repoClient.DeleteConfigSchema(getConfigSchemaKey(in.GetSchemaDetails())); err != nil {
+	key := getConfigSchemaKey(in.GetSchemaDetails())
+	oortEntries, err := services.NewOortDeleteSchemaEntries(key)
+	if err != nil {
+		return &pb.DeleteConfigSchemaResponse{
+			Status:  13,
+			Message: err.Error(),
+		}, nil
+	}
+	eventEntry, err := services.NewSchemaDeletedEntry(in.GetSchemaDetails())
+	if err != nil {
+		return &pb.DeleteConfigSchemaResponse{
+			Status:  13,
+			Message: err.Error(),
+		}, nil
+	}
+	deletedBy, _ := s.authorizer.Subject(ctx)
+	if err := repoClient.DeleteConfigSchema(ctx, key, deletedBy, s.retention, append(oortEntries, eventEntry)...); err != nil {
 		return &pb.DeleteConfigSchemaResponse{
 			Status:  3,
 			Message: err.Error(),
 		}, nil
 	} else {
+		s.outbox.Notify()
 		return &pb.DeleteConfigSchemaResponse{
 			Status:  0,
 			Message: "Schema deleted successfully!",
@@ -208,7 +301,7 @@ func (s *Server) ValidateConfiguration(ctx context.Context, in *pb.ValidateConfi
 			IsValid: isValid,
 		}, nil
 	}
-	repoClient, err := repository.NewClient()
+	repoClient, err := repository.NewClient(s.etcd)
 	if err != nil {
 		return &pb.ValidateConfigurationResponse{
 			Status:  13,
@@ -219,7 +312,7 @@ func (s *Server) ValidateConfiguration(ctx context.Context, in *pb.ValidateConfi
 	defer repoClient.Close()
 
 	key := getConfigSchemaKey(in.GetSchemaDetails())
-	schemaData, err := repoClient.GetConfigSchema(key)
+	schemaData, err := repoClient.GetConfigSchema(ctx, key)
 	if err != nil {
 		return &pb.ValidateConfigurationResponse{
 			Status:  13,
@@ -233,7 +326,7 @@ func (s *Server) ValidateConfiguration(ctx context.Context, in *pb.ValidateConfi
 			IsValid: false,
 		}, nil
 	}
-	validationResult, err := validateConfiguration(in.GetConfiguration(), schemaData.GetSchema())
+	validationResult, err := validation.Validate(in.GetConfiguration(), schemaData.GetSchema())
 	if err != nil {
 		return &pb.ValidateConfigurationResponse{
 			Status:  3,
@@ -241,39 +334,17 @@ func (s *Server) ValidateConfiguration(ctx context.Context, in *pb.ValidateConfi
 			IsValid: false,
 		}, nil
 	}
-	var message string
-	if validationResult.Valid() && message == "" {
-		message = "The configuration is valid!"
-	} else {
-		message = validationResult.Errors()[0].String()
-	}
+	schemaDetails := in.GetSchemaDetails()
+	metrics.ObserveValidation(schemaDetails.GetOrganization()+"/"+schemaDetails.GetNamespace()+"/"+schemaDetails.GetSchemaName(), validationResult.Valid)
 
 	return &pb.ValidateConfigurationResponse{
 		Status:  0,
-		Message: message,
-		IsValid: validationResult.Valid(),
+		Message: validationResult.Message,
+		IsValid: validationResult.Valid,
+		Warning: lifecycleWarning(key, schemaData.GetLifecycle()),
 	}, nil
 }
 
-func validateConfiguration(configuration string, schema string) (*gojsonschema.Result, error) {
-	configurationJson, err := yaml.YAMLToJSON([]byte(configuration))
-	if err != nil {
-		return nil, err
-	}
-	schemaJson, err := yaml.YAMLToJSON([]byte(schema))
-	if err != nil {
-		return nil, err
-	}
-	configLoader := gojsonschema.NewStringLoader(string(configurationJson))
-	schemaLoader := gojsonschema.NewStringLoader(string(schemaJson))
-	result, err := gojsonschema.Validate(schemaLoader, configLoader)
-	if err != nil {
-		return nil, err
-	} else {
-		return result, nil
-	}
-}
-
 func (s *Server) GetConfigSchemaVersions(ctx context.Context, in *pb.ConfigSchemaVersionsRequest) (*pb.ConfigSchemaVersionsResponse, error) {
 	if !s.authorizer.Authorize(ctx, services.PermSchemaPut, services.OortResNamespace, fmt.Sprintf("%s/%s", in.SchemaDetails.Organization, in.SchemaDetails.Namespace)) {
 		return nil, fmt.Errorf("permission denied: %s", services.PermSchemaGet)
@@ -285,7 +356,7 @@ func (s *Server) GetConfigSchemaVersions(ctx context.Context, in *pb.ConfigSchem
 			Message: err.Error(),
 		}, nil
 	}
-	repoClient, err := repository.NewClient()
+	repoClient, err := repository.NewClient(s.etcd)
 	if err != nil {
 		return &pb.ConfigSchemaVersionsResponse{
 			Status:  13,
@@ -295,7 +366,33 @@ func (s *Server) GetConfigSchemaVersions(ctx context.Context, in *pb.ConfigSchem
 	defer repoClient.Close()
 
 	key := getConfigSchemaPrefix(in.GetSchemaDetails())
-	schemaVersions, err := repoClient.GetSchemasByPrefix(key)
+	startAfter, err := decodeVersionPageToken(in.GetPageToken())
+	if err != nil {
+		return &pb.ConfigSchemaVersionsResponse{
+			Status:  3,
+			Message: err.Error(),
+		}, nil
+	}
+	pageSize := int(in.GetPageSize())
+	if pageSize == 0 {
+		pageSize = defaultVersionsPageSize
+	} else if pageSize > maxVersionsPageSize {
+		pageSize = maxVersionsPageSize
+	}
+	versionRange, _ := semverrange.Parse(in.GetVersionRange())
+	query := repository.VersionsQuery{
+		Descending:  in.GetOrder() == pb.VersionOrder_DESCENDING,
+		Contains:    versionRange.Contains,
+		StartAfter:  startAfter,
+		PageSize:    pageSize,
+		SummaryOnly: in.GetView() == pb.ConfigSchemaView_SUMMARY,
+	}
+	if len(in.GetFilter().GetLabels()) > 0 || len(in.GetFilter().GetTags()) > 0 {
+		query.Filter = func(schema *pb.ConfigSchema) bool {
+			return matchesMetadataFilter(schema.GetSchemaData(), in.GetFilter())
+		}
+	}
+	schemaVersions, lastVersion, err := repoClient.GetSchemaVersions(ctx, key, query)
 	if err != nil {
 		return &pb.ConfigSchemaVersionsResponse{
 			Status:  13,
@@ -312,9 +409,22 @@ func (s *Server) GetConfigSchemaVersions(ctx context.Context, in *pb.ConfigSchem
 		Status:         0,
 		Message:        message,
 		SchemaVersions: schemaVersions,
+		NextPageToken:  encodePageToken(lastVersion),
 	}, nil
 }
 
+// decodeVersionPageToken returns the last version of the previous page.
+func decodeVersionPageToken(pageToken string) (string, error) {
+	if pageToken == "" {
+		return "", nil
+	}
+	lastVersion, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil || !semver.IsValid(string(lastVersion)) {
+		return "", errors.New("page token is invalid")
+	}
+	return string(lastVersion), nil
+}
+
 func GetAuthInterceptor() func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		md, ok := metadata.FromIncomingContext(ctx)
@@ -324,3 +434,34 @@ func GetAuthInterceptor() func(ctx context.Context, req interface{}, info *grpc.
 		return handler(ctx, req)
 	}
 }
+
+// recordingStream overrides the context of a stream and remembers the first message received from the client,
+// which determines the scope of the stream.
+type recordingStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	req interface{}
+}
+
+func (s *recordingStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *recordingStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.req == nil {
+		s.req = m
+	}
+	return err
+}
+
+func GetStreamAuthInterceptor() func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+		md, ok := metadata.FromIncomingContext(ctx)
+		if ok && len(md.Get("authz-token")) > 0 {
+			ctx = context.WithValue(ctx, "authz-token", md.Get("authz-token")[0])
+		}
+		return handler(srv, &recordingStream{ServerStream: ss, ctx: ctx})
+	}
+}
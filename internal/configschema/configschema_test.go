@@ -0,0 +1,176 @@
+package configschema
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	meridian_api "github.com/c12s/meridian/pkg/api"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jtomic1/config-schema-service/internal/config"
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	"github.com/jtomic1/config-schema-service/internal/search"
+	"github.com/jtomic1/config-schema-service/internal/services"
+	"github.com/jtomic1/config-schema-service/pkg/validation"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"go.etcd.io/etcd/server/v3/embed"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const testSecretKey = "test-secret"
+
+// runEtcd starts an etcd server which only lives as long as the test.
+func runEtcd(t *testing.T) config.EtcdConfig {
+	t.Helper()
+	cfg := embed.NewConfig()
+	cfg.Dir = t.TempDir()
+	cfg.LogLevel = "error"
+	local, _ := url.Parse("http://127.0.0.1:0")
+	cfg.ListenClientUrls = []url.URL{*local}
+	cfg.ListenPeerUrls = []url.URL{*local}
+	server, err := embed.StartEtcd(cfg)
+	if err != nil {
+		t.Fatalf("failed to start etcd: %v", err)
+	}
+	t.Cleanup(server.Close)
+	select {
+	case <-server.Server.ReadyNotify():
+	case <-time.After(10 * time.Second):
+		t.Fatal("etcd did not become ready")
+	}
+	return config.EtcdConfig{
+		Address:        server.Clients[0].Addr().String(),
+		DialTimeout:    config.Duration(5 * time.Second),
+		RequestTimeout: config.Duration(5 * time.Second),
+	}
+}
+
+// namespaces is a meridian which knows every namespace.
+type namespaces struct {
+	meridian_api.MeridianClient
+}
+
+func (namespaces) GetNamespace(context.Context, *meridian_api.GetNamespaceReq, ...grpc.CallOption) (*meridian_api.GetNamespaceResp, error) {
+	return &meridian_api.GetNamespaceResp{}, nil
+}
+
+// newTestServer starts the service on top of a new etcd server.
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+	cfg := config.Default()
+	cfg.Etcd = runEtcd(t)
+	cfg.Auth.SecretKey = testSecretKey
+	repoClient, err := repository.NewClient(cfg.Etcd)
+	if err != nil {
+		t.Fatalf("failed to connect to etcd: %v", err)
+	}
+	t.Cleanup(repoClient.Close)
+	return NewServer(services.NewAuthZService(cfg.Auth), services.NewOutboxWorker(repoClient), namespaces{}, search.NewIndex(), cfg)
+}
+
+// connectTestServer serves the service in memory with the interceptors which pass the token on, and returns a
+// client which calls it.
+func connectTestServer(t *testing.T, server *Server) pb.ConfigSchemaServiceClient {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(GetAuthInterceptor()),
+		grpc.StreamInterceptor(GetStreamAuthInterceptor()),
+	)
+	pb.RegisterConfigSchemaServiceServer(grpcServer, server)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to connect to the server: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewConfigSchemaServiceClient(conn)
+}
+
+// withToken returns a context whose calls carry a token of the subject with the given permissions.
+func withToken(t *testing.T, subject string, permissions ...string) context.Context {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":         subject,
+		"permissions": strings.Join(permissions, ","),
+	}).SignedString([]byte(testSecretKey))
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return metadata.AppendToOutgoingContext(context.Background(), "authz-token", token)
+}
+
+func TestGetConfigSchemaVersionsReturnsPagesByDefault(t *testing.T) {
+	client := connectTestServer(t, newTestServer(t))
+	ctx := withToken(t, "tester", services.PermSchemaPut+"|"+services.OortResNamespace+"|c12s/prod")
+	for minor := 0; minor < defaultVersionsPageSize+5; minor++ {
+		resp, err := client.SaveConfigSchema(ctx, &pb.SaveConfigSchemaRequest{
+			SchemaDetails: &pb.ConfigSchemaDetails{Organization: "c12s", Namespace: "prod", SchemaName: "database", Version: fmt.Sprintf("v1.%d.0", minor)},
+			Schema:        "type: object",
+		})
+		if err != nil || resp.GetStatus() != 0 {
+			t.Fatalf("failed to save version: %v %v", resp, err)
+		}
+	}
+
+	request := &pb.ConfigSchemaVersionsRequest{
+		SchemaDetails: &pb.ConfigSchemaDetails{Organization: "c12s", Namespace: "prod", SchemaName: "database"},
+	}
+	first, err := client.GetConfigSchemaVersions(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first.GetSchemaVersions()) != defaultVersionsPageSize || first.GetNextPageToken() == "" {
+		t.Fatalf("expected a page of %d versions and a next page, got %d versions and token %q", defaultVersionsPageSize, len(first.GetSchemaVersions()), first.GetNextPageToken())
+	}
+	request.PageToken = first.GetNextPageToken()
+	second, err := client.GetConfigSchemaVersions(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(second.GetSchemaVersions()) != 5 || second.GetNextPageToken() != "" {
+		t.Errorf("expected the remaining 5 versions on the last page, got %d versions and token %q", len(second.GetSchemaVersions()), second.GetNextPageToken())
+	}
+}
+
+func TestValidateConfigurationMatchesLocalValidation(t *testing.T) {
+	client := connectTestServer(t, newTestServer(t))
+	details := &pb.ConfigSchemaDetails{Organization: "c12s", Namespace: "prod", SchemaName: "database", Version: "v1.0.0"}
+	ctx := withToken(t, "tester",
+		services.PermSchemaPut+"|"+services.OortResNamespace+"|c12s/prod",
+		services.PermSchemaGet+"|"+services.OortResSchema+"|c12s/prod/database/v1.0.0",
+	)
+	schema := "type: object\nproperties:\n  port:\n    type: integer\nrequired:\n  - port\n"
+	resp, err := client.SaveConfigSchema(ctx, &pb.SaveConfigSchemaRequest{SchemaDetails: details, Schema: schema})
+	if err != nil || resp.GetStatus() != 0 {
+		t.Fatalf("failed to save schema: %v %v", resp, err)
+	}
+
+	for _, configuration := range []string{"port: 5432", `{"port": 5432}`, "port: \"5432\"", "host: localhost"} {
+		local, err := validation.Validate(configuration, schema)
+		if err != nil {
+			t.Fatal(err)
+		}
+		remote, err := client.ValidateConfiguration(ctx, &pb.ValidateConfigurationRequest{SchemaDetails: details, Configuration: configuration})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if remote.GetIsValid() != local.Valid || remote.GetMessage() != local.Message {
+			t.Errorf("%q: server reported valid %v with message %q, local validation reported valid %v with message %q",
+				configuration, remote.GetIsValid(), remote.GetMessage(), local.Valid, local.Message)
+		}
+	}
+}
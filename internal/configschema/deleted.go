@@ -0,0 +1,103 @@
+package configschema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	"github.com/jtomic1/config-schema-service/internal/services"
+	"github.com/jtomic1/config-schema-service/internal/validators"
+	pb "github.com/jtomic1/config-schema-service/proto"
+)
+
+func (s *Server) RestoreConfigSchema(ctx context.Context, in *pb.RestoreConfigSchemaRequest) (*pb.RestoreConfigSchemaResponse, error) {
+	// the oort resource of a deleted schema no longer exists, so the permission is checked on its namespace
+	if !s.authorizer.Authorize(ctx, services.PermSchemaPut, services.OortResNamespace, fmt.Sprintf("%s/%s", in.SchemaDetails.Organization, in.SchemaDetails.Namespace)) {
+		return nil, fmt.Errorf("permission denied: %s", services.PermSchemaPut)
+	}
+	_, err := validators.IsRestoreConfigSchemaRequestValid(in)
+	if err != nil {
+		return &pb.RestoreConfigSchemaResponse{
+			Status:  3,
+			Message: err.Error(),
+		}, nil
+	}
+	repoClient, err := repository.NewClient(s.etcd)
+	if err != nil {
+		return &pb.RestoreConfigSchemaResponse{
+			Status:  13,
+			Message: "Error while instantiating database client!",
+		}, nil
+	}
+	defer repoClient.Close()
+
+	oortEntry, err := services.NewOortCreateSchemaRelEntry(in.SchemaDetails.Organization, in.SchemaDetails.Namespace, in.SchemaDetails.SchemaName, in.SchemaDetails.Version)
+	if err != nil {
+		return &pb.RestoreConfigSchemaResponse{
+			Status:  13,
+			Message: err.Error(),
+		}, nil
+	}
+	eventEntry, err := services.NewSchemaCreatedEntry(in.GetSchemaDetails())
+	if err != nil {
+		return &pb.RestoreConfigSchemaResponse{
+			Status:  13,
+			Message: err.Error(),
+		}, nil
+	}
+	if err := repoClient.RestoreConfigSchema(ctx, getConfigSchemaKey(in.GetSchemaDetails()), oortEntry, eventEntry); err != nil {
+		return &pb.RestoreConfigSchemaResponse{
+			Status:  3,
+			Message: err.Error(),
+		}, nil
+	}
+	s.outbox.Notify()
+	return &pb.RestoreConfigSchemaResponse{
+		Status:  0,
+		Message: "Schema restored successfully!",
+	}, nil
+}
+
+func (s *Server) ListDeletedConfigSchemas(ctx context.Context, in *pb.ListDeletedConfigSchemasRequest) (*pb.ListDeletedConfigSchemasResponse, error) {
+	if !s.authorizeScope(ctx, services.PermSchemaGet, in.GetOrganization(), in.GetNamespace()) {
+		return nil, fmt.Errorf("permission denied: %s", services.PermSchemaGet)
+	}
+	_, err := validators.IsListDeletedConfigSchemasRequestValid(in)
+	if err != nil {
+		return &pb.ListDeletedConfigSchemasResponse{
+			Status:  3,
+			Message: err.Error(),
+		}, nil
+	}
+	repoClient, err := repository.NewClient(s.etcd)
+	if err != nil {
+		return &pb.ListDeletedConfigSchemasResponse{
+			Status:  13,
+			Message: "Error while instantiating database client!",
+		}, nil
+	}
+	defer repoClient.Close()
+
+	prefix := in.GetOrganization() + "/"
+	if in.GetNamespace() != "" {
+		prefix += in.GetNamespace() + "/"
+	}
+	deletedSchemas, err := repoClient.GetDeletedSchemas(ctx, prefix)
+	if err != nil {
+		return &pb.ListDeletedConfigSchemasResponse{
+			Status:  13,
+			Message: "Error while retrieving deleted schemas!",
+		}, nil
+	}
+	var message string
+	if len(deletedSchemas) == 0 {
+		message = "No deleted schema with prefix '" + prefix + "' found!"
+	} else {
+		message = "Deleted schemas retrieved successfully!"
+	}
+	return &pb.ListDeletedConfigSchemasResponse{
+		Status:         0,
+		Message:        message,
+		DeletedSchemas: deletedSchemas,
+	}, nil
+}
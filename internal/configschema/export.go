@@ -0,0 +1,89 @@
+package configschema
+
+import (
+	"bufio"
+	"fmt"
+
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	"github.com/jtomic1/config-schema-service/internal/services"
+	"github.com/jtomic1/config-schema-service/internal/validators"
+	pb "github.com/jtomic1/config-schema-service/proto"
+)
+
+// exportChunkSize is the largest chunk of the archive sent in a single message.
+const exportChunkSize = 64 << 10
+
+// ExportConfigSchemas streams an archive of every version under the prefix. The archive is sent in chunks,
+// followed by a message without a chunk which reports the outcome, so that clients can tell a complete
+// archive from one which was cut short.
+func (s *Server) ExportConfigSchemas(in *pb.ExportConfigSchemasRequest, stream pb.ConfigSchemaService_ExportConfigSchemasServer) error {
+	ctx := stream.Context()
+	if !s.authorizeScope(ctx, services.PermSchemaGet, in.GetOrganization(), in.GetNamespace()) {
+		return fmt.Errorf("permission denied: %s", services.PermSchemaGet)
+	}
+	_, err := validators.IsExportConfigSchemasRequestValid(in)
+	if err != nil {
+		return stream.Send(&pb.ExportConfigSchemasResponse{
+			Status:  3,
+			Message: err.Error(),
+		})
+	}
+	repoClient, err := repository.NewClient(s.etcd)
+	if err != nil {
+		return stream.Send(&pb.ExportConfigSchemasResponse{
+			Status:  13,
+			Message: "Error while instantiating database client!",
+		})
+	}
+	defer repoClient.Close()
+
+	prefix := requestScope(in) + "/"
+	schemas, err := repoClient.GetSchemasByPrefix(ctx, prefix)
+	if err != nil {
+		return stream.Send(&pb.ExportConfigSchemasResponse{
+			Status:  13,
+			Message: "Error while retrieving schemas!",
+		})
+	} else if len(schemas) == 0 {
+		return stream.Send(&pb.ExportConfigSchemasResponse{
+			Status:  5,
+			Message: "No schema with prefix '" + prefix + "' found!",
+		})
+	}
+	sortBySchemaAndVersion(schemas)
+	w := bufio.NewWriterSize(&exportWriter{stream: stream}, exportChunkSize)
+	err = writeArchive(w, in.GetFormat(), schemas)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return stream.Send(&pb.ExportConfigSchemasResponse{
+			Status:  13,
+			Message: "Error while exporting schemas!",
+		})
+	}
+	return stream.Send(&pb.ExportConfigSchemasResponse{
+		Status:  0,
+		Message: fmt.Sprintf("Exported %d schema versions successfully!", len(schemas)),
+	})
+}
+
+// exportWriter sends what is written to it as chunks of the archive.
+type exportWriter struct {
+	stream pb.ConfigSchemaService_ExportConfigSchemasServer
+}
+
+func (w *exportWriter) Write(p []byte) (int, error) {
+	written := 0
+	for written < len(p) {
+		chunk := p[written:min(len(p), written+exportChunkSize)]
+		if err := w.stream.Send(&pb.ExportConfigSchemasResponse{Status: 0, Chunk: chunk}); err != nil {
+			return written, err
+		}
+		written += len(chunk)
+	}
+	return written, nil
+}
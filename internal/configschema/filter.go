@@ -0,0 +1,27 @@
+package configschema
+
+import (
+	pb "github.com/jtomic1/config-schema-service/proto"
+)
+
+// matchesMetadataFilter reports whether the schema has all labels and tags required by the filter.
+func matchesMetadataFilter(schemaData *pb.ConfigSchemaData, filter *pb.ConfigSchemaMetadataFilter) bool {
+	for key, value := range filter.GetLabels() {
+		if labelValue, ok := schemaData.GetLabels()[key]; !ok || labelValue != value {
+			return false
+		}
+	}
+	for _, tag := range filter.GetTags() {
+		found := false
+		for _, schemaTag := range schemaData.GetTags() {
+			if schemaTag == tag {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
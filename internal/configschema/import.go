@@ -0,0 +1,309 @@
+package configschema
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	meridian_api "github.com/c12s/meridian/pkg/api"
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	"github.com/jtomic1/config-schema-service/internal/services"
+	"github.com/jtomic1/config-schema-service/internal/validators"
+	"github.com/jtomic1/config-schema-service/pkg/validation"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"golang.org/x/mod/semver"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxImportSize limits the size of an imported archive, which is held in memory.
+const maxImportSize = 64 << 20
+
+// ImportConfigSchemas saves the versions of an archive sent in chunks. The first message also carries the
+// format, the conflict policy and whether the import is a dry run. Versions are saved through the same path
+// as SaveConfigSchema, schema by schema in semver order, and are created by the caller. The creator and creation
+// time of the archive are kept as the origin of a version, and its lifecycle state is kept as a single transition
+// made by the caller.
+// Versions which already exist are conflicts, and unless they are skipped, any conflict aborts the import
+// before anything is saved. Other versions which cannot be saved are rejected without affecting the rest.
+func (s *Server) ImportConfigSchemas(stream pb.ConfigSchemaService_ImportConfigSchemasServer) error {
+	ctx := stream.Context()
+	var options *pb.ImportConfigSchemasRequest
+	var archive bytes.Buffer
+	for {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		if options == nil {
+			options = in
+		}
+		if archive.Len()+len(in.GetChunk()) > maxImportSize {
+			return stream.SendAndClose(&pb.ImportConfigSchemasResponse{
+				Status:  8,
+				Message: fmt.Sprintf("Archive exceeds the maximum size of %d MiB!", maxImportSize>>20),
+			})
+		}
+		archive.Write(in.GetChunk())
+	}
+	if options == nil || archive.Len() == 0 {
+		return stream.SendAndClose(&pb.ImportConfigSchemasResponse{
+			Status:  3,
+			Message: "Archive cannot be empty!",
+		})
+	}
+	_, err := validators.IsImportConfigSchemasRequestValid(options)
+	if err != nil {
+		return stream.SendAndClose(&pb.ImportConfigSchemasResponse{
+			Status:  3,
+			Message: err.Error(),
+		})
+	}
+	schemas, err := readArchive(options.GetFormat(), archive.Bytes())
+	if err != nil {
+		return stream.SendAndClose(&pb.ImportConfigSchemasResponse{
+			Status:  3,
+			Message: "Error while reading archive: " + err.Error(),
+		})
+	}
+	sortBySchemaAndVersion(schemas)
+
+	// every namespace of the archive must be writable
+	for namespace := range archiveNamespaces(schemas) {
+		if !s.authorizer.Authorize(ctx, services.PermSchemaPut, services.OortResNamespace, namespace) {
+			return fmt.Errorf("permission denied: %s", services.PermSchemaPut)
+		}
+	}
+	repoClient, err := repository.NewClient(s.etcd)
+	if err != nil {
+		return stream.SendAndClose(&pb.ImportConfigSchemasResponse{
+			Status:  13,
+			Message: "Error while instantiating database client!",
+		})
+	}
+	defer repoClient.Close()
+
+	plan, err := planImport(ctx, repoClient, schemas, s.lookupNamespaces(ctx, schemas), options.GetConflictPolicy())
+	if err != nil {
+		return stream.SendAndClose(&pb.ImportConfigSchemasResponse{
+			Status:  13,
+			Message: "Error while retrieving schemas!",
+		})
+	}
+	var conflicts []*pb.ImportedConfigSchema
+	for _, entry := range plan {
+		if entry.result.GetOutcome() == pb.ImportOutcome_CONFLICTING {
+			conflicts = append(conflicts, entry.result)
+		}
+	}
+	if len(conflicts) > 0 {
+		return stream.SendAndClose(&pb.ImportConfigSchemasResponse{
+			Status:  6,
+			Message: fmt.Sprintf("Import aborted due to %d conflicting versions!", len(conflicts)),
+			Results: conflicts,
+		})
+	}
+	// the token has already been verified by the authorizer, so the subject is only missing if the token has none
+	importedBy, _ := s.authorizer.Subject(ctx)
+	results := s.applyImport(ctx, repoClient, plan, importedBy, options.GetDryRun())
+	counts := make(map[pb.ImportOutcome]int)
+	for _, result := range results {
+		counts[result.GetOutcome()]++
+	}
+	message := "Imported %d versions, skipped %d existing and %d identical versions and rejected %d versions!"
+	if options.GetDryRun() {
+		message = "Dry run: %d versions would be imported, %d existing and %d identical versions skipped and %d versions rejected!"
+	}
+	return stream.SendAndClose(&pb.ImportConfigSchemasResponse{
+		Status:  0,
+		Message: fmt.Sprintf(message, counts[pb.ImportOutcome_IMPORTED], counts[pb.ImportOutcome_SKIPPED], counts[pb.ImportOutcome_IDENTICAL], counts[pb.ImportOutcome_REJECTED]),
+		Results: results,
+	})
+}
+
+// importEntry is a version of an archive together with the request which saves it, which is nil if the
+// version is not saved.
+type importEntry struct {
+	schema  *pb.ConfigSchema
+	request *pb.SaveConfigSchemaRequest
+	result  *pb.ImportedConfigSchema
+}
+
+// archiveNamespaces returns the namespaces of the versions as "org/namespace". Versions without an organization
+// or namespace are left out, as they are rejected anyway.
+func archiveNamespaces(schemas []*pb.ConfigSchema) map[string]bool {
+	namespaces := make(map[string]bool)
+	for _, schema := range schemas {
+		details := schema.GetSchemaDetails()
+		if details.GetOrganization() != "" && details.GetNamespace() != "" {
+			namespaces[details.GetOrganization()+"/"+details.GetNamespace()] = true
+		}
+	}
+	return namespaces
+}
+
+// lookupNamespaces asks meridian about every namespace of the versions. Namespaces which meridian does not
+// know map to the error it returned, and the versions in them are rejected.
+func (s *Server) lookupNamespaces(ctx context.Context, schemas []*pb.ConfigSchema) map[string]error {
+	namespaces := make(map[string]error)
+	for namespace := range archiveNamespaces(schemas) {
+		org, name, _ := strings.Cut(namespace, "/")
+		_, namespaces[namespace] = s.meridian.GetNamespace(ctx, &meridian_api.GetNamespaceReq{
+			OrgId: org,
+			Name:  name,
+		})
+	}
+	return namespaces
+}
+
+// planImport decides the outcome of every version, in the order in which they are saved. Versions which
+// are to be saved are reported as imported, which they are unless saving them fails.
+func planImport(ctx context.Context, repoClient *repository.EtcdRepository, schemas []*pb.ConfigSchema, namespaces map[string]error, policy pb.ImportConflictPolicy) ([]*importEntry, error) {
+	plan := make([]*importEntry, len(schemas))
+	latestVersions := make(map[string]string)
+	seen := make(map[string]bool)
+	for i, schema := range schemas {
+		details := schema.GetSchemaDetails()
+		entry := &importEntry{
+			schema: schema,
+			result: &pb.ImportedConfigSchema{SchemaDetails: details, Outcome: pb.ImportOutcome_REJECTED},
+		}
+		plan[i] = entry
+		request := &pb.SaveConfigSchemaRequest{
+			SchemaDetails: details,
+			Schema:        schema.GetSchemaData().GetSchema(),
+			Description:   schema.GetSchemaData().GetDescription(),
+			Labels:        schema.GetSchemaData().GetLabels(),
+			Tags:          schema.GetSchemaData().GetTags(),
+		}
+		key := getConfigSchemaKey(details)
+		if _, err := validators.IsImportedSchemaValid(request); err != nil {
+			entry.result.Message = err.Error()
+			continue
+		} else if _, ok := pb.ConfigSchemaState_name[int32(schema.GetSchemaData().GetLifecycle().GetState())]; !ok {
+			entry.result.Message = "Lifecycle state must be ACTIVE, DEPRECATED or YANKED!"
+			continue
+		} else if seen[key] {
+			entry.result.Message = "Version is provided more than once!"
+			continue
+		} else if err := namespaces[details.GetOrganization()+"/"+details.GetNamespace()]; err != nil {
+			entry.result.Message = err.Error()
+			continue
+		}
+		seen[key] = true
+
+		existing, err := repoClient.GetConfigSchema(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			switch {
+			case policy == pb.ImportConflictPolicy_SKIP_EXISTING:
+				entry.result.Outcome = pb.ImportOutcome_SKIPPED
+				entry.result.Message = "Version already exists!"
+			case policy == pb.ImportConflictPolicy_OVERWRITE_IF_IDENTICAL && isIdentical(existing, schema.GetSchemaData()):
+				entry.result.Outcome = pb.ImportOutcome_IDENTICAL
+				entry.result.Message = "Version already exists with the same content!"
+			case policy == pb.ImportConflictPolicy_OVERWRITE_IF_IDENTICAL:
+				entry.result.Outcome = pb.ImportOutcome_CONFLICTING
+				entry.result.Message = "Version already exists with different content!"
+			default:
+				entry.result.Outcome = pb.ImportOutcome_CONFLICTING
+				entry.result.Message = "Version already exists!"
+			}
+			continue
+		}
+
+		prefix := getConfigSchemaPrefix(details)
+		latestVersion, ok := latestVersions[prefix]
+		if !ok {
+			if latestVersion, err = repoClient.GetLatestVersionByPrefix(ctx, prefix); err != nil {
+				return nil, err
+			}
+			latestVersions[prefix] = latestVersion
+		}
+		if latestVersion != "" && semver.Compare(details.GetVersion(), latestVersion) != 1 {
+			entry.result.Message = "Provided version is not latest! Please provide a version that succeeds '" + latestVersion + "'!"
+			continue
+		}
+		// yanked versions are never considered latest, as when they are saved
+		if schema.GetSchemaData().GetLifecycle().GetState() != pb.ConfigSchemaState_YANKED {
+			latestVersions[prefix] = details.GetVersion()
+		}
+		entry.request = request
+		entry.result.Outcome = pb.ImportOutcome_IMPORTED
+		entry.result.Message = "Version will be imported!"
+	}
+	return plan, nil
+}
+
+// applyImport saves the planned versions in order as created by importedBy, unless it is a dry run, and returns
+// the outcome of every version. Versions which fail to save are rejected.
+func (s *Server) applyImport(ctx context.Context, repoClient *repository.EtcdRepository, plan []*importEntry, importedBy string, dryRun bool) []*pb.ImportedConfigSchema {
+	results := make([]*pb.ImportedConfigSchema, len(plan))
+	for i, entry := range plan {
+		if entry.request != nil && !dryRun {
+			resp := s.saveConfigSchema(ctx, repoClient, entry.request, importOrigin(entry.schema.GetSchemaData(), importedBy))
+			if resp.GetStatus() != 0 {
+				entry.result.Outcome = pb.ImportOutcome_REJECTED
+			}
+			entry.result.Message = resp.GetMessage()
+		}
+		results[i] = entry.result
+	}
+	return results
+}
+
+// importOrigin returns the metadata with which an archived version is saved. Anyone who may import can write
+// any archive, so the version is created by the importer at the time of the import, and the creator and creation
+// time of the archive are only kept as its origin. The lifecycle state is kept, as yanked versions must not become
+// the latest version, but the transitions of the archive are replaced by a single transition made by the importer.
+func importOrigin(archived *pb.ConfigSchemaData, importedBy string) *pb.ConfigSchemaData {
+	origin := &pb.ConfigSchemaData{CreatedBy: importedBy}
+	if archived.GetCreatedBy() != "" || archived.GetCreationTime() != nil {
+		origin.ImportedFrom = &pb.ConfigSchemaOrigin{
+			CreatedBy:    archived.GetCreatedBy(),
+			CreationTime: archived.GetCreationTime(),
+		}
+	}
+	lifecycle := archived.GetLifecycle()
+	if lifecycle.GetState() != pb.ConfigSchemaState_ACTIVE {
+		origin.Lifecycle = &pb.ConfigSchemaLifecycle{
+			State:              lifecycle.GetState(),
+			Message:            lifecycle.GetMessage(),
+			ReplacementVersion: lifecycle.GetReplacementVersion(),
+			Transitions: []*pb.ConfigSchemaLifecycleTransition{{
+				FromState:  pb.ConfigSchemaState_ACTIVE,
+				ToState:    lifecycle.GetState(),
+				Message:    lifecycle.GetMessage(),
+				ChangedBy:  importedBy,
+				ChangeTime: timestamppb.Now(),
+			}},
+		}
+	}
+	return origin
+}
+
+// isIdentical reports whether an archived version has the same schema and metadata as the stored one.
+func isIdentical(stored *pb.ConfigSchemaData, archived *pb.ConfigSchemaData) bool {
+	return isSameSchema(stored.GetSchema(), archived.GetSchema()) &&
+		stored.GetDescription() == archived.GetDescription() &&
+		maps.Equal(stored.GetLabels(), archived.GetLabels()) &&
+		slices.Equal(stored.GetTags(), archived.GetTags())
+}
+
+// isSameSchema compares schemas in their stored form, so that formatting differences do not matter.
+func isSameSchema(stored string, other string) bool {
+	storedSchema, storedErr := validation.Normalize(stored)
+	otherSchema, otherErr := validation.Normalize(other)
+	if errors.Join(storedErr, otherErr) != nil {
+		return false
+	}
+	return bytes.Equal(storedSchema, otherSchema)
+}
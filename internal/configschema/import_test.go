@@ -0,0 +1,78 @@
+package configschema
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/jtomic1/config-schema-service/internal/services"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestImportRecordsImporterAndOrigin(t *testing.T) {
+	client := connectTestServer(t, newTestServer(t))
+	ctx := withToken(t, "importer",
+		services.PermSchemaPut+"|"+services.OortResNamespace+"|c12s/prod",
+		services.PermSchemaGet+"|"+services.OortResSchema+"|c12s/prod/database/v1.0.0",
+	)
+	details := &pb.ConfigSchemaDetails{Organization: "c12s", Namespace: "prod", SchemaName: "database", Version: "v1.0.0"}
+	archivedTime := timestamppb.New(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	var archive bytes.Buffer
+	err := writeArchive(&archive, pb.ArchiveFormat_NDJSON, []*pb.ConfigSchema{{
+		SchemaDetails: details,
+		SchemaData: &pb.ConfigSchemaData{
+			Schema:       "type: object",
+			CreationTime: archivedTime,
+			CreatedBy:    "someone-else",
+			Lifecycle: &pb.ConfigSchemaLifecycle{
+				State:   pb.ConfigSchemaState_DEPRECATED,
+				Message: "use v2",
+				Transitions: []*pb.ConfigSchemaLifecycleTransition{{
+					ToState:    pb.ConfigSchemaState_DEPRECATED,
+					ChangedBy:  "someone-else",
+					ChangeTime: archivedTime,
+				}},
+			},
+		},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stream, err := client.ImportConfigSchemas(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := stream.Send(&pb.ImportConfigSchemasRequest{Chunk: archive.Bytes()}); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetStatus() != 0 || len(resp.GetResults()) != 1 || resp.GetResults()[0].GetOutcome() != pb.ImportOutcome_IMPORTED {
+		t.Fatalf("expected the version to be imported, got %v", resp)
+	}
+
+	got, err := client.GetConfigSchema(ctx, &pb.GetConfigSchemaRequest{SchemaDetails: details})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := got.GetSchemaData()
+	if data.GetCreatedBy() != "importer" {
+		t.Errorf("expected the version to be created by the importer, got %q", data.GetCreatedBy())
+	}
+	if !data.GetCreationTime().AsTime().After(archivedTime.AsTime()) {
+		t.Errorf("expected the version to be created at the time of the import, got %v", data.GetCreationTime().AsTime())
+	}
+	expectedOrigin := &pb.ConfigSchemaOrigin{CreatedBy: "someone-else", CreationTime: archivedTime}
+	if !proto.Equal(data.GetImportedFrom(), expectedOrigin) {
+		t.Errorf("expected imported_from %v, got %v", expectedOrigin, data.GetImportedFrom())
+	}
+	transitions := data.GetLifecycle().GetTransitions()
+	if data.GetLifecycle().GetState() != pb.ConfigSchemaState_DEPRECATED || len(transitions) != 1 || transitions[0].GetChangedBy() != "importer" {
+		t.Errorf("expected the state to be kept with a single transition by the importer, got %v", data.GetLifecycle())
+	}
+}
@@ -0,0 +1,129 @@
+package configschema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	"github.com/jtomic1/config-schema-service/internal/services"
+	"github.com/jtomic1/config-schema-service/internal/validators"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) SetConfigSchemaLifecycle(ctx context.Context, in *pb.SetConfigSchemaLifecycleRequest) (*pb.SetConfigSchemaLifecycleResponse, error) {
+	oortSchemaId := services.OortSchemaId(in.SchemaDetails.Organization, in.SchemaDetails.Namespace, in.SchemaDetails.SchemaName, in.SchemaDetails.Version)
+	if !s.authorizer.Authorize(ctx, services.PermSchemaPut, services.OortResSchema, oortSchemaId) {
+		return nil, fmt.Errorf("permission denied: %s", services.PermSchemaPut)
+	}
+	_, err := validators.IsSetConfigSchemaLifecycleRequestValid(in)
+	if err != nil {
+		return &pb.SetConfigSchemaLifecycleResponse{
+			Status:  3,
+			Message: err.Error(),
+		}, nil
+	}
+	repoClient, err := repository.NewClient(s.etcd)
+	if err != nil {
+		return &pb.SetConfigSchemaLifecycleResponse{
+			Status:  13,
+			Message: "Error while instantiating database client!",
+		}, nil
+	}
+	defer repoClient.Close()
+
+	if in.GetReplacementVersion() != "" {
+		replacementDetails := &pb.ConfigSchemaDetails{
+			Organization: in.GetSchemaDetails().GetOrganization(),
+			Namespace:    in.GetSchemaDetails().GetNamespace(),
+			SchemaName:   in.GetSchemaDetails().GetSchemaName(),
+			Version:      in.GetReplacementVersion(),
+		}
+		replacement, err := repoClient.GetConfigSchema(ctx, getConfigSchemaKey(replacementDetails))
+		if err != nil {
+			return &pb.SetConfigSchemaLifecycleResponse{
+				Status:  13,
+				Message: "Error while retrieving schema!",
+			}, nil
+		} else if replacement == nil || replacement.GetLifecycle().GetState() == pb.ConfigSchemaState_YANKED {
+			return &pb.SetConfigSchemaLifecycleResponse{
+				Status:  3,
+				Message: "Replacement version '" + in.GetReplacementVersion() + "' does not exist or has been yanked!",
+			}, nil
+		}
+	}
+
+	changedBy, _ := s.authorizer.Subject(ctx)
+	key := getConfigSchemaKey(in.GetSchemaDetails())
+	errSameState := errors.New("Schema is already in state " + in.GetState().String() + "!")
+	found, err := repoClient.UpdateConfigSchema(ctx, key, func(schemaData *pb.ConfigSchemaData) ([]*repository.OutboxEntry, error) {
+		lifecycle := schemaData.GetLifecycle()
+		if lifecycle == nil {
+			lifecycle = &pb.ConfigSchemaLifecycle{}
+		}
+		if lifecycle.GetState() == in.GetState() {
+			return nil, errSameState
+		}
+		lifecycle.Transitions = append(lifecycle.Transitions, &pb.ConfigSchemaLifecycleTransition{
+			FromState:  lifecycle.GetState(),
+			ToState:    in.GetState(),
+			Message:    in.GetMessage(),
+			ChangedBy:  changedBy,
+			ChangeTime: timestamppb.Now(),
+		})
+		lifecycle.State = in.GetState()
+		lifecycle.Message = in.GetMessage()
+		lifecycle.ReplacementVersion = in.GetReplacementVersion()
+		schemaData.Lifecycle = lifecycle
+		if in.GetState() != pb.ConfigSchemaState_DEPRECATED {
+			return nil, nil
+		}
+		eventEntry, err := services.NewSchemaDeprecatedEntry(in.GetSchemaDetails(), in.GetMessage(), in.GetReplacementVersion())
+		if err != nil {
+			return nil, err
+		}
+		return []*repository.OutboxEntry{eventEntry}, nil
+	})
+	if errors.Is(err, errSameState) {
+		return &pb.SetConfigSchemaLifecycleResponse{
+			Status:  9,
+			Message: err.Error(),
+		}, nil
+	} else if err != nil {
+		return &pb.SetConfigSchemaLifecycleResponse{
+			Status:  13,
+			Message: "Error while updating schema lifecycle!",
+		}, nil
+	} else if !found {
+		return &pb.SetConfigSchemaLifecycleResponse{
+			Status:  5,
+			Message: "No schema with key '" + key + "' found!",
+		}, nil
+	}
+	s.outbox.Notify()
+	return &pb.SetConfigSchemaLifecycleResponse{
+		Status:  0,
+		Message: "Schema state changed to " + in.GetState().String() + " successfully!",
+	}, nil
+}
+
+// lifecycleWarning returns the warning which accompanies the use of a deprecated or yanked schema.
+func lifecycleWarning(key string, lifecycle *pb.ConfigSchemaLifecycle) string {
+	var warning string
+	switch lifecycle.GetState() {
+	case pb.ConfigSchemaState_DEPRECATED:
+		warning = "Schema '" + key + "' is deprecated!"
+		if lifecycle.GetReplacementVersion() != "" {
+			warning += " Please use version '" + lifecycle.GetReplacementVersion() + "' instead."
+		}
+	case pb.ConfigSchemaState_YANKED:
+		warning = "Schema '" + key + "' has been yanked!"
+	default:
+		return ""
+	}
+	if lifecycle.GetMessage() != "" {
+		warning += " " + lifecycle.GetMessage()
+	}
+	return warning
+}
@@ -0,0 +1,98 @@
+package configschema
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	"github.com/jtomic1/config-schema-service/internal/services"
+	"github.com/jtomic1/config-schema-service/internal/validators"
+	pb "github.com/jtomic1/config-schema-service/proto"
+)
+
+const (
+	defaultListPageSize = 20
+	maxListPageSize     = 100
+)
+
+func (s *Server) ListConfigSchemas(ctx context.Context, in *pb.ListConfigSchemasRequest) (*pb.ListConfigSchemasResponse, error) {
+	if !s.authorizeScope(ctx, services.PermSchemaGet, in.GetOrganization(), in.GetNamespace()) {
+		return nil, fmt.Errorf("permission denied: %s", services.PermSchemaGet)
+	}
+	_, err := validators.IsListConfigSchemasRequestValid(in)
+	if err != nil {
+		return &pb.ListConfigSchemasResponse{
+			Status:  3,
+			Message: err.Error(),
+		}, nil
+	}
+	prefix := in.GetOrganization() + "/"
+	if in.GetNamespace() != "" {
+		prefix += in.GetNamespace() + "/"
+	}
+	startAfter, err := decodePageToken(in.GetPageToken(), prefix)
+	if err != nil {
+		return &pb.ListConfigSchemasResponse{
+			Status:  3,
+			Message: err.Error(),
+		}, nil
+	}
+	pageSize := int(in.GetPageSize())
+	if pageSize == 0 {
+		pageSize = defaultListPageSize
+	} else if pageSize > maxListPageSize {
+		pageSize = maxListPageSize
+	}
+	repoClient, err := repository.NewClient(s.etcd)
+	if err != nil {
+		return &pb.ListConfigSchemasResponse{
+			Status:  13,
+			Message: "Error while instantiating database client!",
+		}, nil
+	}
+	defer repoClient.Close()
+
+	summaries, lastPrefix, err := repoClient.ListSchemas(ctx, prefix, startAfter, pageSize, func(summary *pb.ConfigSchemaSummary) bool {
+		return matchesMetadataFilter(summary.GetSchemaData(), in.GetFilter())
+	})
+	if err != nil {
+		return &pb.ListConfigSchemasResponse{
+			Status:  13,
+			Message: "Error while listing schemas!",
+		}, nil
+	}
+	var message string
+	if len(summaries) == 0 {
+		message = "No schema with prefix '" + prefix + "' found!"
+	} else {
+		message = "Schemas listed successfully!"
+	}
+	return &pb.ListConfigSchemasResponse{
+		Status:        0,
+		Message:       message,
+		Schemas:       summaries,
+		NextPageToken: encodePageToken(lastPrefix),
+	}, nil
+}
+
+func encodePageToken(lastKey string) string {
+	if lastKey == "" {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(lastKey))
+}
+
+// decodePageToken returns the key from which the next page continues, and checks that it belongs to the listed prefix.
+func decodePageToken(pageToken string, prefix string) (string, error) {
+	if pageToken == "" {
+		return "", nil
+	}
+	lastKey, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil || !strings.HasPrefix(string(lastKey), prefix) {
+		return "", errors.New("page token is invalid")
+	}
+	return string(lastKey), nil
+}
@@ -0,0 +1,114 @@
+package configschema
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jtomic1/config-schema-service/internal/logging"
+	"github.com/jtomic1/config-schema-service/internal/services"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+const requestIdHeader = "x-request-id"
+
+var scopeFields = []string{"organization", "namespace", "schema_name", "version"}
+
+// GetLoggingInterceptor assigns an ID to every request, attaches a logger with the fields of the request to its context
+// and logs the outcome of the call. It must follow the interceptor which extracts the token.
+func GetLoggingInterceptor(authorizer *services.AuthZService) func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !isConfigSchemaMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		start := time.Now()
+		requestId := incomingRequestId(ctx)
+		if err := grpc.SetHeader(ctx, metadata.Pairs(requestIdHeader, requestId)); err != nil {
+			return nil, err
+		}
+		logger := requestLogger(ctx, authorizer, requestId, info.FullMethod).With(scopeAttrs(req)...)
+		ctx = logging.NewContext(logging.WithRequestId(ctx, requestId), logger)
+		resp, err := handler(ctx, req)
+		logOutcome(ctx, logger, start, resp, err)
+		return resp, err
+	}
+}
+
+// GetStreamLoggingInterceptor does the same for streams. The fields which describe the scope of a stream
+// are only known once the client has sent its request, so they are added to the final record only.
+func GetStreamLoggingInterceptor(authorizer *services.AuthZService) func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !isConfigSchemaMethod(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		start := time.Now()
+		requestId := incomingRequestId(ss.Context())
+		if err := ss.SetHeader(metadata.Pairs(requestIdHeader, requestId)); err != nil {
+			return err
+		}
+		logger := requestLogger(ss.Context(), authorizer, requestId, info.FullMethod)
+		ctx := logging.NewContext(logging.WithRequestId(ss.Context(), requestId), logger)
+		stream := &recordingStream{ServerStream: ss, ctx: ctx}
+		err := handler(srv, stream)
+		logOutcome(ctx, logger.With(scopeAttrs(stream.req)...), start, nil, err)
+		return err
+	}
+}
+
+// isConfigSchemaMethod reports whether the method belongs to the schema service, as opposed to e.g. health checks,
+// which are neither logged nor audited.
+func isConfigSchemaMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/"+pb.ConfigSchemaService_ServiceDesc.ServiceName+"/")
+}
+
+func requestLogger(ctx context.Context, authorizer *services.AuthZService, requestId string, method string) *slog.Logger {
+	subject, _ := authorizer.Subject(ctx)
+	logger := slog.Default().With("request_id", requestId, "method", method, "subject", subject)
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		logger = logger.With("trace_id", spanContext.TraceID().String())
+	}
+	return logger
+}
+
+func scopeAttrs(req interface{}) []any {
+	var attrs []any
+	for i, segment := range requestSegments(req) {
+		attrs = append(attrs, scopeFields[i], segment)
+	}
+	return attrs
+}
+
+// logOutcome logs failures caused by the client as warnings, and failures of the service as errors.
+func logOutcome(ctx context.Context, logger *slog.Logger, start time.Time, resp interface{}, err error) {
+	code, message := callOutcome(resp, err)
+	level := slog.LevelInfo
+	switch codes.Code(code) {
+	case codes.OK:
+	case codes.Unknown, codes.Internal, codes.Unavailable, codes.DataLoss:
+		level = slog.LevelError
+	default:
+		level = slog.LevelWarn
+	}
+	logger.Log(ctx, level, "request completed", "code", codes.Code(code).String(), "message", message, "latency_ms", float64(time.Since(start).Microseconds())/1000)
+}
+
+// incomingRequestId returns the request ID sent by the client, or generates a new one.
+func incomingRequestId(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok && len(md.Get(requestIdHeader)) > 0 && md.Get(requestIdHeader)[0] != "" {
+		return md.Get(requestIdHeader)[0]
+	}
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(id)
+}
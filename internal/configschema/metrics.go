@@ -0,0 +1,31 @@
+package configschema
+
+import (
+	"context"
+	"time"
+
+	"github.com/jtomic1/config-schema-service/internal/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// GetMetricsInterceptor counts every call by its result code and measures its latency.
+func GetMetricsInterceptor() func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		code, _ := callOutcome(resp, err)
+		metrics.ObserveRequest(info.FullMethod, codes.Code(code).String(), time.Since(start))
+		return resp, err
+	}
+}
+
+func GetStreamMetricsInterceptor() func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		code, _ := callOutcome(nil, err)
+		metrics.ObserveRequest(info.FullMethod, codes.Code(code).String(), time.Since(start))
+		return err
+	}
+}
@@ -0,0 +1,120 @@
+package configschema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	"github.com/jtomic1/config-schema-service/internal/services"
+	"github.com/jtomic1/config-schema-service/internal/validators"
+	pb "github.com/jtomic1/config-schema-service/proto"
+)
+
+func (s *Server) ReconcileOortRelationships(ctx context.Context, in *pb.ReconcileOortRelationshipsRequest) (*pb.ReconcileOortRelationshipsResponse, error) {
+	if !s.authorizer.Authorize(ctx, services.PermSchemaPut, services.OortResOrg, in.GetOrganization()) {
+		return nil, fmt.Errorf("permission denied: %s", services.PermSchemaPut)
+	}
+	_, err := validators.IsReconcileOortRelationshipsRequestValid(in)
+	if err != nil {
+		return &pb.ReconcileOortRelationshipsResponse{
+			Status:  3,
+			Message: err.Error(),
+		}, nil
+	}
+	repoClient, err := repository.NewClient(s.etcd)
+	if err != nil {
+		return &pb.ReconcileOortRelationshipsResponse{
+			Status:  13,
+			Message: "Error while instantiating database client!",
+		}, nil
+	}
+	defer repoClient.Close()
+
+	prefix := in.GetOrganization() + "/"
+	if in.GetNamespace() != "" {
+		prefix += in.GetNamespace() + "/"
+	}
+	missing, err := findMissingOortRelationships(ctx, repoClient, prefix)
+	if err != nil {
+		return &pb.ReconcileOortRelationshipsResponse{
+			Status:  13,
+			Message: "Error while reconciling oort relationships!",
+		}, nil
+	}
+	if in.GetRepair() {
+		var entries []*repository.OutboxEntry
+		for _, relationship := range missing {
+			if relationship.GetPending() {
+				continue
+			}
+			details := relationship.GetSchemaDetails()
+			entry, err := services.NewOortCreateSchemaRelEntry(details.GetOrganization(), details.GetNamespace(), details.GetSchemaName(), details.GetVersion())
+			if err != nil {
+				return &pb.ReconcileOortRelationshipsResponse{
+					Status:  13,
+					Message: err.Error(),
+				}, nil
+			}
+			entries = append(entries, entry)
+			relationship.Pending = true
+		}
+		if len(entries) > 0 {
+			if err := repoClient.EnqueueOutboxEntries(ctx, entries...); err != nil {
+				return &pb.ReconcileOortRelationshipsResponse{
+					Status:  13,
+					Message: "Error while scheduling oort relationships!",
+				}, nil
+			}
+			s.outbox.Notify()
+		}
+	}
+	var message string
+	if len(missing) == 0 {
+		message = "All schemas with prefix '" + prefix + "' have their oort relationships!"
+	} else {
+		message = fmt.Sprintf("Found %d schemas with missing oort relationships!", len(missing))
+	}
+	return &pb.ReconcileOortRelationshipsResponse{
+		Status:               0,
+		Message:              message,
+		MissingRelationships: missing,
+	}, nil
+}
+
+// findMissingOortRelationships reports every schema under the prefix which oort has not acknowledged,
+// together with the state of its pending delivery, if there is one.
+func findMissingOortRelationships(ctx context.Context, repoClient *repository.EtcdRepository, prefix string) ([]*pb.MissingOortRelationship, error) {
+	schemaDetails, err := repoClient.GetSchemaDetailsByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	syncedKeys, err := repoClient.GetOortSyncedKeys(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := repoClient.GetOutboxEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pendingEntries := make(map[string]*repository.OutboxEntry)
+	for _, entry := range entries {
+		if entry.Kind == services.OutboxKindOortCreateSchemaRel {
+			pendingEntries[entry.SchemaKey] = entry
+		}
+	}
+	var missing []*pb.MissingOortRelationship
+	for _, details := range schemaDetails {
+		key := getConfigSchemaKey(details)
+		if syncedKeys[key] {
+			continue
+		}
+		relationship := &pb.MissingOortRelationship{SchemaDetails: details}
+		if entry, ok := pendingEntries[key]; ok {
+			relationship.Pending = true
+			relationship.Attempts = entry.Attempts
+			relationship.LastError = entry.LastError
+		}
+		missing = append(missing, relationship)
+	}
+	return missing, nil
+}
@@ -0,0 +1,64 @@
+package configschema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jtomic1/config-schema-service/internal/search"
+	"github.com/jtomic1/config-schema-service/internal/services"
+	"github.com/jtomic1/config-schema-service/internal/validators"
+	pb "github.com/jtomic1/config-schema-service/proto"
+)
+
+const (
+	defaultSearchResults = 50
+	maxSearchResults     = 200
+)
+
+func (s *Server) SearchConfigSchemas(ctx context.Context, in *pb.SearchConfigSchemasRequest) (*pb.SearchConfigSchemasResponse, error) {
+	if !s.authorizeScope(ctx, services.PermSchemaGet, in.GetOrganization(), in.GetNamespace()) {
+		return nil, fmt.Errorf("permission denied: %s", services.PermSchemaGet)
+	}
+	_, err := validators.IsSearchConfigSchemasRequestValid(in)
+	if err != nil {
+		return &pb.SearchConfigSchemasResponse{
+			Status:  3,
+			Message: err.Error(),
+		}, nil
+	}
+	prefix := in.GetOrganization() + "/"
+	if in.GetNamespace() != "" {
+		prefix += in.GetNamespace() + "/"
+	}
+	maxResults := int(in.GetMaxResults())
+	if maxResults == 0 {
+		maxResults = defaultSearchResults
+	} else if maxResults > maxSearchResults {
+		maxResults = maxSearchResults
+	}
+	schemaDetails, matchedProperties := s.index.Search(search.Query{
+		Prefix:       prefix,
+		Text:         in.GetText(),
+		PropertyPath: in.GetPropertyPath(),
+		Keywords:     in.GetKeywords(),
+		MaxResults:   maxResults,
+	})
+	results := make([]*pb.ConfigSchemaSearchResult, len(schemaDetails))
+	for i, details := range schemaDetails {
+		results[i] = &pb.ConfigSchemaSearchResult{
+			SchemaDetails:     details,
+			MatchedProperties: matchedProperties[i],
+		}
+	}
+	var message string
+	if len(results) == 0 {
+		message = "No schema with prefix '" + prefix + "' matches the query!"
+	} else {
+		message = "Schemas found successfully!"
+	}
+	return &pb.SearchConfigSchemasResponse{
+		Status:  0,
+		Message: message,
+		Results: results,
+	}, nil
+}
@@ -0,0 +1,265 @@
+package configschema
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jtomic1/config-schema-service/internal/config"
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	pb "github.com/jtomic1/config-schema-service/proto"
+)
+
+// schemaFileExtensions are the extensions of schema files in the working tree. Other files, such as a
+// README, are ignored.
+var schemaFileExtensions = map[string]bool{".yaml": true, ".yml": true, ".json": true}
+
+// SchemaSyncer mirrors a working tree of a git repository into etcd. The tree is laid out as
+// org/namespace/name/version.yaml, and every file holds the schema of a version. Versions which are not
+// stored yet are saved through the same path as SaveConfigSchema, schema by schema in semver order, and
+// versions which fail validation are rejected without affecting the rest. Saved versions cannot change, so
+// versions whose schema differs from the stored one are only reported, as is drift: versions which are
+// stored but missing from the tree. Only organizations which have a directory in the tree are compared.
+type SchemaSyncer struct {
+	server *Server
+	cfg    config.SyncConfig
+	// reported holds what was last logged about every version, so that a version is only logged again
+	// once its outcome changes.
+	reported map[string]string
+}
+
+func NewSchemaSyncer(server *Server, cfg config.SyncConfig) *SchemaSyncer {
+	return &SchemaSyncer{
+		server:   server,
+		cfg:      cfg,
+		reported: make(map[string]string),
+	}
+}
+
+// syncReport is the outcome of a sync.
+type syncReport struct {
+	// results holds the outcome of every version missing from etcd, which is either imported or rejected.
+	results   []*pb.ImportedConfigSchema
+	unchanged int
+	// modified are versions whose schema in the tree differs from the stored one.
+	modified []*pb.ConfigSchemaDetails
+	// drift are versions which are stored but missing from the tree.
+	drift []*pb.ConfigSchemaDetails
+}
+
+// Run syncs the working tree right away, and then periodically until the context is cancelled.
+func (s *SchemaSyncer) Run(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(s.cfg.Interval))
+	defer ticker.Stop()
+	for {
+		report, err := s.sync(ctx)
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err != nil:
+			slog.Error("error while syncing schemas from git", "directory", s.cfg.Directory, "error", err)
+		default:
+			s.log(report)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *SchemaSyncer) sync(ctx context.Context) (*syncReport, error) {
+	tree, organizations, err := readSchemaTree(s.cfg.Directory)
+	if err != nil {
+		return nil, err
+	}
+	repoClient, err := repository.NewClient(s.server.etcd)
+	if err != nil {
+		return nil, err
+	}
+	defer repoClient.Close()
+
+	stored := make(map[string]*pb.ConfigSchema)
+	for _, org := range organizations {
+		schemas, err := repoClient.GetSchemasByPrefix(ctx, org+"/")
+		if err != nil {
+			return nil, err
+		}
+		for _, schema := range schemas {
+			stored[getConfigSchemaKey(schema.GetSchemaDetails())] = schema
+		}
+	}
+	files := make(map[string]int)
+	for _, schema := range tree {
+		files[getConfigSchemaKey(schema.GetSchemaDetails())]++
+	}
+	report := &syncReport{}
+	var missing []*pb.ConfigSchema
+	for _, schema := range tree {
+		key := getConfigSchemaKey(schema.GetSchemaDetails())
+		// a version with more than one file, e.g. .yaml and .json, is ambiguous, so none of its files is used
+		if count := files[key]; count != 1 {
+			if count > 1 {
+				report.results = append(report.results, &pb.ImportedConfigSchema{
+					SchemaDetails: schema.GetSchemaDetails(),
+					Outcome:       pb.ImportOutcome_REJECTED,
+					Message:       "Version is provided by more than one file!",
+				})
+				files[key] = 0
+			}
+			delete(stored, key)
+			continue
+		}
+		existing, ok := stored[key]
+		if !ok {
+			missing = append(missing, schema)
+			continue
+		}
+		delete(stored, key)
+		if isSameSchema(existing.GetSchemaData().GetSchema(), schema.GetSchemaData().GetSchema()) {
+			report.unchanged++
+		} else {
+			report.modified = append(report.modified, schema.GetSchemaDetails())
+		}
+	}
+	var drift []*pb.ConfigSchema
+	for _, schema := range stored {
+		drift = append(drift, schema)
+	}
+	sortBySchemaAndVersion(drift)
+	for _, schema := range drift {
+		report.drift = append(report.drift, schema.GetSchemaDetails())
+	}
+
+	sortBySchemaAndVersion(missing)
+	plan, err := planImport(ctx, repoClient, missing, s.server.lookupNamespaces(ctx, missing), pb.ImportConflictPolicy_FAIL_ON_CONFLICT)
+	if err != nil {
+		return nil, err
+	}
+	report.results = append(report.results, s.server.applyImport(ctx, repoClient, plan, s.cfg.CreatedBy, s.cfg.DryRun)...)
+	return report, nil
+}
+
+// log reports the outcome of a sync. Versions are logged when their outcome first appears or changes. In a dry
+// run, the outcome is the plan of what would be saved, which is logged in full after every sync.
+func (s *SchemaSyncer) log(report *syncReport) {
+	reported := make(map[string]string)
+	summaryLevel := slog.LevelDebug
+	if s.cfg.DryRun {
+		summaryLevel = slog.LevelInfo
+	}
+	logOnce := func(level slog.Level, msg string, details *pb.ConfigSchemaDetails, args ...any) {
+		key := getConfigSchemaKey(details)
+		reported[key] = msg
+		if s.cfg.DryRun || s.reported[key] != msg {
+			summaryLevel = slog.LevelInfo
+			slog.Log(context.Background(), level, msg, append([]any{"schema", key}, args...)...)
+		}
+	}
+	counts := make(map[pb.ImportOutcome]int)
+	for _, result := range report.results {
+		counts[result.GetOutcome()]++
+		switch {
+		case result.GetOutcome() != pb.ImportOutcome_IMPORTED:
+			logOnce(slog.LevelWarn, "sync rejected version", result.GetSchemaDetails(), "reason", result.GetMessage())
+		case s.cfg.DryRun:
+			logOnce(slog.LevelInfo, "sync would save version", result.GetSchemaDetails())
+		default:
+			logOnce(slog.LevelInfo, "sync saved version", result.GetSchemaDetails())
+		}
+	}
+	for _, details := range report.modified {
+		logOnce(slog.LevelWarn, "version in git differs from the stored version, which cannot be changed", details)
+	}
+	for _, details := range report.drift {
+		logOnce(slog.LevelWarn, "stored version is missing from git", details)
+	}
+	s.reported = reported
+
+	msg := "synced schemas from git"
+	if s.cfg.DryRun {
+		msg = "planned sync of schemas from git (dry run)"
+	}
+	// outside of a dry run, the summary is only logged at info level when something has changed since the last sync
+	slog.Log(context.Background(), summaryLevel, msg,
+		"imported", counts[pb.ImportOutcome_IMPORTED],
+		"rejected", len(report.results)-counts[pb.ImportOutcome_IMPORTED],
+		"unchanged", report.unchanged,
+		"modified", len(report.modified),
+		"drift", len(report.drift),
+	)
+}
+
+// readSchemaTree returns the versions of the working tree and the organizations which have a directory in
+// it. Hidden files and directories, such as .git, are skipped. The root is resolved first, so that a tree
+// which is swapped by replacing a symbolic link is read from a single checkout.
+func readSchemaTree(dir string) ([]*pb.ConfigSchema, []string, error) {
+	root, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return nil, nil, err
+	}
+	var organizations []string
+	for _, entry := range entries {
+		if entry.IsDir() && !strings.HasPrefix(entry.Name(), ".") {
+			organizations = append(organizations, entry.Name())
+		}
+	}
+	var schemas []*pb.ConfigSchema
+	err = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == root {
+			return nil
+		}
+		if strings.HasPrefix(entry.Name(), ".") {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		extension := filepath.Ext(entry.Name())
+		if entry.IsDir() || !schemaFileExtensions[extension] {
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		segments := strings.Split(filepath.ToSlash(rel), "/")
+		if len(segments) != 4 {
+			slog.Warn("skipping schema file which is not laid out as org/namespace/name/version", "file", rel)
+			return nil
+		}
+		schema, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		schemas = append(schemas, &pb.ConfigSchema{
+			SchemaDetails: &pb.ConfigSchemaDetails{
+				Organization: segments[0],
+				Namespace:    segments[1],
+				SchemaName:   segments[2],
+				Version:      strings.TrimSuffix(segments[3], extension),
+			},
+			SchemaData: &pb.ConfigSchemaData{
+				Schema: string(schema),
+			},
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error while reading '%s': %w", dir, err)
+	}
+	return schemas, organizations, nil
+}
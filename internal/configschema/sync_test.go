@@ -0,0 +1,231 @@
+package configschema
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/jtomic1/config-schema-service/internal/config"
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	pb "github.com/jtomic1/config-schema-service/proto"
+)
+
+// writeTree writes the files, given by their path relative to the root, and returns the root.
+func writeTree(t *testing.T, root string, files map[string]string) string {
+	t.Helper()
+	for path, content := range files {
+		file := filepath.Join(root, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(file, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func schemaKeys(schemas []*pb.ConfigSchema) []string {
+	keys := make([]string, len(schemas))
+	for i, schema := range schemas {
+		keys[i] = getConfigSchemaKey(schema.GetSchemaDetails())
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func detailsKeys(details []*pb.ConfigSchemaDetails) []string {
+	keys := make([]string, len(details))
+	for i, d := range details {
+		keys[i] = getConfigSchemaKey(d)
+	}
+	return keys
+}
+
+func TestReadSchemaTreeLayout(t *testing.T) {
+	root := writeTree(t, t.TempDir(), map[string]string{
+		"c12s/prod/database/v1.0.0.yaml":       "type: object",
+		"c12s/prod/database/v1.1.0.json":       `{"type": "object"}`,
+		"c12s/prod/cache/v2.0.0.yml":           "type: object",
+		"c12s/prod/database/README.md":         "not a schema",
+		"c12s/prod/v1.0.0.yaml":                "type: object",
+		"c12s/prod/database/nested/v1.0.0.yml": "type: object",
+		"c12s/prod/.drafts/v3.0.0.yaml":        "type: object",
+		"c12s/.staging/database/v1.0.0.yaml":   "type: object",
+		".git/objects/ab/v1.0.0.yaml":          "type: object",
+		"other/README.md":                      "no schemas yet",
+	})
+
+	schemas, organizations, err := readSchemaTree(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"c12s/prod/cache/v2.0.0", "c12s/prod/database/v1.0.0", "c12s/prod/database/v1.1.0"}
+	if keys := schemaKeys(schemas); !slices.Equal(keys, expected) {
+		t.Errorf("expected versions %v, got %v", expected, keys)
+	}
+	if !slices.Equal(organizations, []string{"c12s", "other"}) {
+		t.Errorf("expected organizations [c12s other], got %v", organizations)
+	}
+}
+
+func TestReadSchemaTreeKeepsEveryFileOfAVersion(t *testing.T) {
+	root := writeTree(t, t.TempDir(), map[string]string{
+		"c12s/prod/database/v1.0.0.yaml": "type: object",
+		"c12s/prod/database/v1.0.0.json": `{"type": "object"}`,
+	})
+	schemas, _, err := readSchemaTree(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// both files are returned, so that the sync can reject the version instead of picking one of them
+	if keys := schemaKeys(schemas); !slices.Equal(keys, []string{"c12s/prod/database/v1.0.0", "c12s/prod/database/v1.0.0"}) {
+		t.Errorf("expected both files of the version, got %v", keys)
+	}
+}
+
+func outcomes(report *syncReport) map[string]pb.ImportOutcome {
+	outcomes := make(map[string]pb.ImportOutcome)
+	for _, result := range report.results {
+		outcomes[getConfigSchemaKey(result.GetSchemaDetails())] = result.GetOutcome()
+	}
+	return outcomes
+}
+
+func TestSyncReportsModifiedVersionsAndDrift(t *testing.T) {
+	server := newTestServer(t)
+	root := writeTree(t, t.TempDir(), map[string]string{
+		"c12s/prod/database/v1.0.0.yaml": "type: object",
+		"c12s/prod/database/v1.1.0.yaml": "type: object",
+		"c12s/prod/database/v1.2.0.yaml": "type: object\nrequired: [port]",
+	})
+	syncer := NewSchemaSyncer(server, config.SyncConfig{Directory: root, CreatedBy: "git-sync"})
+	report, err := syncer.sync(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.results) != 3 || len(report.modified) != 0 || len(report.drift) != 0 {
+		t.Fatalf("expected 3 imported versions, got %v", outcomes(report))
+	}
+
+	// v1.0.0 changes, v1.1.0 is removed, v1.2.0 is only reformatted and v1.3.0 is added with two files
+	if err := os.Remove(filepath.Join(root, "c12s/prod/database/v1.1.0.yaml")); err != nil {
+		t.Fatal(err)
+	}
+	writeTree(t, root, map[string]string{
+		"c12s/prod/database/v1.0.0.yaml": "type: array",
+		"c12s/prod/database/v1.2.0.yaml": "type: object\nrequired:\n  - port\n",
+		"c12s/prod/database/v1.3.0.yaml": "type: object",
+		"c12s/prod/database/v1.3.0.json": `{"type": "object"}`,
+	})
+	report, err = syncer.sync(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if modified := detailsKeys(report.modified); !slices.Equal(modified, []string{"c12s/prod/database/v1.0.0"}) {
+		t.Errorf("expected v1.0.0 to be modified, got %v", modified)
+	}
+	if drift := detailsKeys(report.drift); !slices.Equal(drift, []string{"c12s/prod/database/v1.1.0"}) {
+		t.Errorf("expected v1.1.0 to be drift, got %v", drift)
+	}
+	if report.unchanged != 1 {
+		t.Errorf("expected the reformatted v1.2.0 to be unchanged, got %d unchanged versions", report.unchanged)
+	}
+	expected := map[string]pb.ImportOutcome{"c12s/prod/database/v1.3.0": pb.ImportOutcome_REJECTED}
+	if got := outcomes(report); len(got) != 1 || got["c12s/prod/database/v1.3.0"] != expected["c12s/prod/database/v1.3.0"] {
+		t.Errorf("expected the version with two files to be rejected, got %v", got)
+	}
+}
+
+func TestSyncDryRunSavesNothing(t *testing.T) {
+	server := newTestServer(t)
+	root := writeTree(t, t.TempDir(), map[string]string{
+		"c12s/prod/database/v1.0.0.yaml": "type: object",
+		"c12s/prod/database/v1.1.0.yaml": "type: [",
+	})
+	syncer := NewSchemaSyncer(server, config.SyncConfig{Directory: root, DryRun: true, CreatedBy: "git-sync"})
+	report, err := syncer.sync(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := outcomes(report)
+	if got["c12s/prod/database/v1.0.0"] != pb.ImportOutcome_IMPORTED || got["c12s/prod/database/v1.1.0"] != pb.ImportOutcome_REJECTED {
+		t.Errorf("expected v1.0.0 to be planned and v1.1.0 to be rejected, got %v", got)
+	}
+	repoClient, err := repository.NewClient(server.etcd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer repoClient.Close()
+	if stored, err := repoClient.GetConfigSchema(context.Background(), "c12s/prod/database/v1.0.0"); err != nil || stored != nil {
+		t.Errorf("expected nothing to be saved in a dry run, got %v %v", stored, err)
+	}
+}
+
+// recorder keeps the records which are logged.
+type recorder struct {
+	mu      sync.Mutex
+	records []slog.Record
+}
+
+func (r *recorder) Enabled(context.Context, slog.Level) bool { return true }
+func (r *recorder) WithAttrs([]slog.Attr) slog.Handler       { return r }
+func (r *recorder) WithGroup(string) slog.Handler            { return r }
+
+func (r *recorder) Handle(_ context.Context, record slog.Record) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.records = append(r.records, record)
+	return nil
+}
+
+// take returns the messages logged at info level or above since the last call.
+func (r *recorder) take() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var messages []string
+	for _, record := range r.records {
+		if record.Level >= slog.LevelInfo {
+			messages = append(messages, record.Message)
+		}
+	}
+	r.records = nil
+	return messages
+}
+
+func TestSyncLogsTheFullPlanOnEveryDryRun(t *testing.T) {
+	logs := &recorder{}
+	defaultLogger := slog.Default()
+	slog.SetDefault(slog.New(logs))
+	t.Cleanup(func() { slog.SetDefault(defaultLogger) })
+
+	details := &pb.ConfigSchemaDetails{Organization: "c12s", Namespace: "prod", SchemaName: "database", Version: "v1.0.0"}
+	drifted := &pb.ConfigSchemaDetails{Organization: "c12s", Namespace: "prod", SchemaName: "database", Version: "v0.9.0"}
+	report := &syncReport{
+		results: []*pb.ImportedConfigSchema{{SchemaDetails: details, Outcome: pb.ImportOutcome_IMPORTED}},
+		drift:   []*pb.ConfigSchemaDetails{drifted},
+	}
+	plan := []string{"sync would save version", "stored version is missing from git", "planned sync of schemas from git (dry run)"}
+	dryRun := NewSchemaSyncer(nil, config.SyncConfig{DryRun: true})
+	for i := 0; i < 2; i++ {
+		dryRun.log(report)
+		if messages := logs.take(); !slices.Equal(messages, plan) {
+			t.Errorf("dry run %d: expected %v, got %v", i+1, plan, messages)
+		}
+	}
+
+	// outside of a dry run, versions are only logged when their outcome changes
+	syncer := NewSchemaSyncer(nil, config.SyncConfig{})
+	syncer.log(report)
+	if messages := logs.take(); len(messages) != 3 {
+		t.Errorf("expected the first sync to log every version and the summary, got %v", messages)
+	}
+	syncer.log(report)
+	if messages := logs.take(); len(messages) != 0 {
+		t.Errorf("expected a sync without changes to log nothing at info level, got %v", messages)
+	}
+}
@@ -0,0 +1,90 @@
+package configschema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	"github.com/jtomic1/config-schema-service/internal/services"
+	"github.com/jtomic1/config-schema-service/internal/validators"
+	pb "github.com/jtomic1/config-schema-service/proto"
+)
+
+func (s *Server) WatchConfigSchemas(in *pb.WatchConfigSchemasRequest, stream pb.ConfigSchemaService_WatchConfigSchemasServer) error {
+	// watches are ended when the server shuts down, so that they do not hold up draining
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	go func() {
+		select {
+		case <-s.shutdown:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	if !s.authorizeScope(ctx, services.PermSchemaGet, in.GetOrganization(), in.GetNamespace()) {
+		return fmt.Errorf("permission denied: %s", services.PermSchemaGet)
+	}
+	_, err := validators.IsWatchConfigSchemasRequestValid(in)
+	if err != nil {
+		return stream.Send(&pb.WatchConfigSchemasResponse{
+			Status:  3,
+			Message: err.Error(),
+		})
+	}
+	repoClient, err := repository.NewClient(s.etcd)
+	if err != nil {
+		return stream.Send(&pb.WatchConfigSchemasResponse{
+			Status:  13,
+			Message: "Error while instantiating database client!",
+		})
+	}
+	defer repoClient.Close()
+
+	lastRevision := in.GetStartRevision() - 1
+	err = repoClient.WatchSchemas(ctx, getWatchPrefix(in), in.GetStartRevision(), func(change *repository.SchemaChange) error {
+		lastRevision = change.Revision
+		return stream.Send(&pb.WatchConfigSchemasResponse{
+			Status:        0,
+			EventType:     change.EventType,
+			SchemaDetails: change.SchemaDetails,
+			Revision:      change.Revision,
+		})
+	})
+	var compactedErr *repository.ErrRevisionCompacted
+	if errors.As(err, &compactedErr) {
+		return stream.Send(&pb.WatchConfigSchemasResponse{
+			Status:   11,
+			Message:  "Revision " + fmt.Sprint(in.GetStartRevision()) + " is no longer available! Please restart the watch from revision " + fmt.Sprint(compactedErr.CompactRevision) + " or later!",
+			Revision: compactedErr.CompactRevision,
+		})
+	} else if s.isShuttingDown() && stream.Context().Err() == nil {
+		message := "Server is shutting down! Please restart the watch"
+		if lastRevision >= 0 {
+			message += " from revision " + fmt.Sprint(lastRevision+1)
+		}
+		return stream.Send(&pb.WatchConfigSchemasResponse{
+			Status:  14,
+			Message: message + "!",
+		})
+	} else if err != nil && ctx.Err() == nil {
+		return stream.Send(&pb.WatchConfigSchemasResponse{
+			Status:  13,
+			Message: "Error while watching schemas!",
+		})
+	}
+	return nil
+}
+
+// getWatchPrefix returns the key prefix of the watched schemas. Every level ends with a separator,
+// so that watching a schema does not include other schemas whose names start the same way.
+func getWatchPrefix(in *pb.WatchConfigSchemasRequest) string {
+	prefix := in.GetOrganization() + "/"
+	if in.GetNamespace() != "" {
+		prefix += in.GetNamespace() + "/"
+	}
+	if in.GetSchemaName() != "" {
+		prefix += in.GetSchemaName() + "/"
+	}
+	return prefix
+}
@@ -0,0 +1,174 @@
+package gateway
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/jtomic1/config-schema-service/internal/config"
+	"github.com/jtomic1/config-schema-service/internal/services"
+	"github.com/jtomic1/config-schema-service/internal/web"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	requestIdHeader = "x-request-id"
+	bufferSize      = 1 << 20
+)
+
+// Gateway serves the REST API. Requests are forwarded to an in-memory gRPC server which runs the same
+// interceptors as the public one, so that REST calls are authorized, logged, audited and measured alike.
+// The identity of a verified client certificate is forwarded with the call, so that callers without a
+// token are identified by their certificate as they are over gRPC.
+type Gateway struct {
+	grpcServer *grpc.Server
+	listener   *bufconn.Listener
+	conn       *grpc.ClientConn
+	httpServer *http.Server
+}
+
+// New creates the gateway listening on the address. The server options carry the interceptors, and the
+// TLS configuration is used for the HTTP listener if it is not nil.
+func New(addr string, tlsCfg *tls.Config, corsCfg config.CORSConfig, service pb.ConfigSchemaServiceServer, opts ...grpc.ServerOption) (*Gateway, error) {
+	listener := bufconn.Listen(bufferSize)
+	grpcServer := grpc.NewServer(append(opts, grpc.Creds(services.GatewayCredentials()))...)
+	pb.RegisterConfigSchemaServiceServer(grpcServer, service)
+	conn, err := grpc.NewClient("passthrough:///gateway",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	jsonMarshaler := newJSONMarshaler()
+	yaml := &yamlMarshaler{JSONPb: jsonMarshaler}
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, jsonMarshaler),
+		runtime.WithMarshalerOption("application/yaml", yaml),
+		runtime.WithMarshalerOption("application/x-yaml", yaml),
+		runtime.WithIncomingHeaderMatcher(incomingHeader),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeader),
+		runtime.WithMetadata(callMetadata),
+		runtime.WithForwardResponseOption(responseStatus),
+		runtime.WithErrorHandler(errorHandler),
+	)
+	if err := pb.RegisterConfigSchemaServiceHandler(context.Background(), mux, conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	handler := http.NewServeMux()
+	handler.Handle("/v1/", mux)
+	handler.HandleFunc("/openapi.json", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(pb.OpenAPI)
+	})
+	return &Gateway{
+		grpcServer: grpcServer,
+		listener:   listener,
+		conn:       conn,
+		httpServer: &http.Server{
+			Addr:      addr,
+			Handler:   web.CORS(corsCfg, handler),
+			TLSConfig: tlsCfg,
+		},
+	}, nil
+}
+
+// Serve blocks until the gateway is shut down, returning nil in that case.
+func (g *Gateway) Serve() error {
+	go g.grpcServer.Serve(g.listener)
+	var err error
+	if g.httpServer.TLSConfig != nil {
+		err = g.httpServer.ListenAndServeTLS("", "")
+	} else {
+		err = g.httpServer.ListenAndServe()
+	}
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// Shutdown stops accepting requests and waits for in-flight requests until the context is done.
+func (g *Gateway) Shutdown(ctx context.Context) error {
+	err := g.httpServer.Shutdown(ctx)
+	g.conn.Close()
+	g.grpcServer.Stop()
+	return err
+}
+
+// callMetadata passes the token of the Authorization header on as the authz-token of gRPC calls, and the
+// identity of the verified client certificate of the HTTP client on to the authorizer.
+func callMetadata(_ context.Context, req *http.Request) metadata.MD {
+	md := metadata.MD{}
+	if token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer "); ok && token != "" {
+		md.Set("authz-token", token)
+	}
+	if req.TLS != nil {
+		if identity, ok := services.CertificateIdentity(*req.TLS); ok {
+			md.Set(services.CertificateIdentityMetadata, identity)
+		}
+	}
+	return md
+}
+
+// incomingHeader forwards the request ID and the default headers. The certificate identity is only set by
+// the gateway itself, so HTTP clients cannot claim one with a header.
+func incomingHeader(key string) (string, bool) {
+	if strings.EqualFold(key, requestIdHeader) {
+		return requestIdHeader, true
+	}
+	name, ok := runtime.DefaultHeaderMatcher(key)
+	if ok && strings.EqualFold(name, services.CertificateIdentityMetadata) {
+		return "", false
+	}
+	return name, ok
+}
+
+func outgoingHeader(key string) (string, bool) {
+	if key == requestIdHeader {
+		return "X-Request-Id", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// statusResponse is implemented by every unary response, whose status holds a gRPC code.
+type statusResponse interface {
+	GetStatus() int32
+}
+
+// responseStatus turns the status of a response into the HTTP status code. Watch events and export chunks
+// are written after the stream has started, so their status stays in the body.
+func responseStatus(_ context.Context, w http.ResponseWriter, resp proto.Message) error {
+	switch resp.(type) {
+	case *pb.WatchConfigSchemasResponse, *pb.ExportConfigSchemasResponse:
+		return nil
+	}
+	if withStatus, ok := resp.(statusResponse); ok && withStatus.GetStatus() != 0 {
+		w.WriteHeader(runtime.HTTPStatusFromCode(codes.Code(withStatus.GetStatus())))
+	}
+	return nil
+}
+
+// errorHandler answers with 403 when the call is not permitted, as the service reports it as an unknown error.
+func errorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if s, ok := status.FromError(err); ok && s.Code() == codes.Unknown && strings.HasPrefix(s.Message(), "permission denied") {
+		err = status.Error(codes.PermissionDenied, s.Message())
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
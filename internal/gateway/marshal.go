@@ -0,0 +1,76 @@
+package gateway
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+	"sigs.k8s.io/yaml"
+)
+
+func newJSONMarshaler() *runtime.JSONPb {
+	return &runtime.JSONPb{
+		MarshalOptions: protojson.MarshalOptions{
+			// the status of successful calls is 0, which would otherwise be left out
+			EmitUnpopulated: true,
+		},
+		UnmarshalOptions: protojson.UnmarshalOptions{
+			DiscardUnknown: true,
+		},
+	}
+}
+
+// yamlMarshaler reads and writes the same documents as the JSON marshaler, written as YAML.
+type yamlMarshaler struct {
+	*runtime.JSONPb
+}
+
+func (m *yamlMarshaler) ContentType(_ interface{}) string {
+	return "application/yaml"
+}
+
+func (m *yamlMarshaler) Marshal(v interface{}) ([]byte, error) {
+	jsonData, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.JSONToYAML(jsonData)
+}
+
+func (m *yamlMarshaler) Unmarshal(data []byte, v interface{}) error {
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return err
+	}
+	return m.JSONPb.Unmarshal(jsonData, v)
+}
+
+func (m *yamlMarshaler) NewDecoder(r io.Reader) runtime.Decoder {
+	return runtime.DecoderFunc(func(v interface{}) error {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		if len(bytes.TrimSpace(data)) == 0 {
+			return io.EOF
+		}
+		return m.Unmarshal(data, v)
+	})
+}
+
+func (m *yamlMarshaler) NewEncoder(w io.Writer) runtime.Encoder {
+	return runtime.EncoderFunc(func(v interface{}) error {
+		data, err := m.Marshal(v)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	})
+}
+
+// Delimiter separates the messages of a stream, such as the events of a watch, into YAML documents.
+func (m *yamlMarshaler) Delimiter() []byte {
+	return []byte("---\n")
+}
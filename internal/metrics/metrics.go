@@ -0,0 +1,88 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "quasar"
+
+var (
+	requests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "grpc_requests_total",
+		Help:      "Number of handled gRPC calls, by method and result code.",
+	}, []string{"method", "code"})
+	requestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "grpc_request_duration_seconds",
+		Help:      "Latency of gRPC calls, by method. Streams are measured until they end.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+	validations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "schema_validations_total",
+		Help:      "Number of validated configurations, by schema and result.",
+	}, []string{"schema", "result"})
+	etcdDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "etcd_operation_duration_seconds",
+		Help:      "Latency of etcd operations, by operation.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+	}, []string{"operation"})
+	etcdErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "etcd_operation_errors_total",
+		Help:      "Number of failed etcd operations, by operation.",
+	}, []string{"operation"})
+	authorizationDenials = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "authorization_denials_total",
+		Help:      "Number of denied authorization checks, by permission.",
+	}, []string{"permission"})
+	deliveryFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "outbox_delivery_failures_total",
+		Help:      "Number of failed deliveries to oort and NATS, by outbox entry kind.",
+	}, []string{"kind"})
+)
+
+func ObserveRequest(method string, code string, duration time.Duration) {
+	requests.WithLabelValues(method, code).Inc()
+	requestDuration.WithLabelValues(method).Observe(duration.Seconds())
+}
+
+// ObserveValidation counts a validation against a schema, which is identified without its version.
+func ObserveValidation(schema string, valid bool) {
+	result := "invalid"
+	if valid {
+		result = "valid"
+	}
+	validations.WithLabelValues(schema, result).Inc()
+}
+
+func ObserveEtcdOperation(operation string, duration time.Duration, err error) {
+	etcdDuration.WithLabelValues(operation).Observe(duration.Seconds())
+	if err != nil {
+		etcdErrors.WithLabelValues(operation).Inc()
+	}
+}
+
+func ObserveAuthorizationDenial(permission string) {
+	authorizationDenials.WithLabelValues(permission).Inc()
+}
+
+func ObserveDeliveryFailure(kind string) {
+	deliveryFailures.WithLabelValues(kind).Inc()
+}
+
+// NewServer creates an HTTP server which exposes the metrics on /metrics of the given address.
+func NewServer(address string) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	return &http.Server{Addr: address, Handler: mux}
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/jtomic1/config-schema-service/proto"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const auditPrefix = "_audit/"
+
+// AuditKeyPrefix returns the prefix of the audit entries of an organization.
+func AuditKeyPrefix(organization string) string {
+	return auditPrefix + organization + "/"
+}
+
+func auditKey(organization string, sequence int64) string {
+	return fmt.Sprintf("%s%020d", AuditKeyPrefix(organization), sequence)
+}
+
+// AppendAuditEntry stores the entry under the organization which the schema key belongs to.
+// Entries are never overwritten, so the audit log is append-only. Sequences are only increasing within a
+// process, so when another replica has taken the key, the entry is stored under the next free sequence.
+func (repo *EtcdRepository) AppendAuditEntry(ctx context.Context, entry *pb.AuditLogEntry) error {
+	ctx, done := repo.startOperation(ctx, "AppendAuditEntry")
+	defer done()
+	organization, _, _ := strings.Cut(entry.GetSchemaKey(), "/")
+	serializedEntry, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	for {
+		key := auditKey(organization, nextSequence(entry.GetTime().AsTime()))
+		res, err := repo.client.Txn(ctx).
+			If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0)).
+			Then(clientv3.OpPut(key, string(serializedEntry))).
+			Commit()
+		if err != nil {
+			return err
+		}
+		if res.Succeeded {
+			return nil
+		}
+	}
+}
+
+// QueryAuditLog returns up to limit audit entries of the organization which were recorded within [start, end)
+// and are accepted by the filter, in the order in which they were recorded, starting after the key startAfter.
+// Zero times leave the range open. The returned string is the key of the last returned entry if there are
+// more entries to read, and empty otherwise.
+func (repo *EtcdRepository) QueryAuditLog(ctx context.Context, organization string, start time.Time, end time.Time, startAfter string, limit int, filter func(entry *pb.AuditLogEntry) bool) ([]*pb.AuditLogEntry, string, error) {
+	ctx, done := repo.startOperation(ctx, "QueryAuditLog")
+	defer done()
+	prefix := AuditKeyPrefix(organization)
+	from := prefix
+	if !start.IsZero() {
+		from = auditKey(organization, start.UnixNano())
+	}
+	if startAfter != "" && startAfter >= from {
+		from = startAfter + "\x00"
+	}
+	to := clientv3.GetPrefixRangeEnd(prefix)
+	if !end.IsZero() {
+		to = auditKey(organization, end.UnixNano())
+	}
+	var entries []*pb.AuditLogEntry
+	var revision int64
+	for from < to {
+		opts := []clientv3.OpOption{clientv3.WithRange(to), clientv3.WithLimit(listBatchSize)}
+		if revision > 0 {
+			opts = append(opts, clientv3.WithRev(revision))
+		}
+		res, err := repo.client.Get(ctx, from, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+		revision = res.Header.Revision
+		for i, kv := range res.Kvs {
+			var entry pb.AuditLogEntry
+			if err := json.Unmarshal(kv.Value, &entry); err != nil {
+				return nil, "", err
+			}
+			if filter != nil && !filter(&entry) {
+				continue
+			}
+			entries = append(entries, &entry)
+			if len(entries) == limit {
+				if i < len(res.Kvs)-1 || res.More {
+					return entries, string(kv.Key), nil
+				}
+				return entries, "", nil
+			}
+		}
+		if !res.More {
+			break
+		}
+		from = string(res.Kvs[len(res.Kvs)-1].Key) + "\x00"
+	}
+	return entries, "", nil
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jtomic1/config-schema-service/internal/logging"
+	"github.com/jtomic1/config-schema-service/internal/metrics"
+	"github.com/jtomic1/config-schema-service/internal/tracing"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentedKV traces and logs every etcd operation of the repository together with its latency, and records the latency in metrics.
+type instrumentedKV struct {
+	clientv3.KV
+}
+
+// start opens the span of an etcd operation. The returned function ends it, and records the outcome of the operation.
+func (kv *instrumentedKV) start(ctx context.Context, operation string, key string) (context.Context, func(err error)) {
+	start := time.Now()
+	ctx, span := tracing.Tracer().Start(ctx, "etcd."+operation, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attribute.String("etcd.key", key)))
+	return ctx, func(err error) {
+		latency := time.Since(start)
+		metrics.ObserveEtcdOperation(operation, latency, err)
+		tracing.RecordError(span, err)
+		span.End()
+		attrs := []any{"operation", operation, "key", key, "latency_ms", float64(latency.Microseconds()) / 1000}
+		if err != nil {
+			logging.FromContext(ctx).WarnContext(ctx, "etcd operation failed", append(attrs, "error", err)...)
+			return
+		}
+		logging.FromContext(ctx).DebugContext(ctx, "etcd operation", attrs...)
+	}
+}
+
+func (kv *instrumentedKV) Get(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error) {
+	ctx, end := kv.start(ctx, "get", key)
+	res, err := kv.KV.Get(ctx, key, opts...)
+	end(err)
+	return res, err
+}
+
+func (kv *instrumentedKV) Put(ctx context.Context, key string, val string, opts ...clientv3.OpOption) (*clientv3.PutResponse, error) {
+	ctx, end := kv.start(ctx, "put", key)
+	res, err := kv.KV.Put(ctx, key, val, opts...)
+	end(err)
+	return res, err
+}
+
+func (kv *instrumentedKV) Delete(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.DeleteResponse, error) {
+	ctx, end := kv.start(ctx, "delete", key)
+	res, err := kv.KV.Delete(ctx, key, opts...)
+	end(err)
+	return res, err
+}
+
+func (kv *instrumentedKV) Txn(ctx context.Context) clientv3.Txn {
+	return &instrumentedTxn{Txn: kv.KV.Txn(ctx), ctx: ctx, kv: kv}
+}
+
+type instrumentedTxn struct {
+	clientv3.Txn
+	ctx context.Context
+	kv  *instrumentedKV
+}
+
+func (txn *instrumentedTxn) If(cs ...clientv3.Cmp) clientv3.Txn {
+	txn.Txn = txn.Txn.If(cs...)
+	return txn
+}
+
+func (txn *instrumentedTxn) Then(ops ...clientv3.Op) clientv3.Txn {
+	txn.Txn = txn.Txn.Then(ops...)
+	return txn
+}
+
+func (txn *instrumentedTxn) Else(ops ...clientv3.Op) clientv3.Txn {
+	txn.Txn = txn.Txn.Else(ops...)
+	return txn
+}
+
+// Commit is measured from the moment it is called, because the operations of a transaction are only sent then.
+func (txn *instrumentedTxn) Commit() (*clientv3.TxnResponse, error) {
+	_, end := txn.kv.start(txn.ctx, "txn", "")
+	res, err := txn.Txn.Commit()
+	end(err)
+	return res, err
+}
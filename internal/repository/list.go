@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+
+	pb "github.com/jtomic1/config-schema-service/proto"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"golang.org/x/mod/semver"
+)
+
+const listBatchSize = 500
+
+// schemaGroup collects the versions of a single schema while keys are being scanned.
+type schemaGroup struct {
+	prefix        string
+	latestKey     string
+	latestVersion string
+	versionCount  int32
+}
+
+// ListSchemas returns summaries of up to pageSize schemas under the prefix which are accepted by the filter,
+// starting after the schema whose key prefix is given as startAfter. Keys are read without values, so only
+// the metadata of the latest version of each schema is loaded. The returned string is the key prefix of the
+// last returned schema if there are more schemas to list, and empty otherwise.
+func (repo *EtcdRepository) ListSchemas(ctx context.Context, prefix string, startAfter string, pageSize int, filter func(summary *pb.ConfigSchemaSummary) bool) ([]*pb.ConfigSchemaSummary, string, error) {
+	ctx, done := repo.startOperation(ctx, "ListSchemas")
+	defer done()
+	start := prefix
+	if startAfter != "" {
+		start = clientv3.GetPrefixRangeEnd(startAfter)
+	}
+	end := clientv3.GetPrefixRangeEnd(prefix)
+	var summaries []*pb.ConfigSchemaSummary
+	var group *schemaGroup
+	var revision int64
+	for {
+		opts := []clientv3.OpOption{clientv3.WithRange(end), clientv3.WithKeysOnly(), clientv3.WithLimit(listBatchSize)}
+		if revision > 0 {
+			// every batch is read at the same revision, so that the page is a consistent snapshot
+			opts = append(opts, clientv3.WithRev(revision))
+		}
+		res, err := repo.client.Get(ctx, start, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+		revision = res.Header.Revision
+		for _, kv := range res.Kvs {
+			key := string(kv.Key)
+			version := keyVersion(key)
+			schemaPrefix := key[:len(key)-len(version)]
+			if group != nil && group.prefix != schemaPrefix {
+				summaries, err = repo.appendSummary(ctx, summaries, group, revision, filter)
+				if err != nil {
+					return nil, "", err
+				}
+				if len(summaries) == pageSize {
+					return summaries, group.prefix, nil
+				}
+				group = nil
+			}
+			if group == nil {
+				group = &schemaGroup{prefix: schemaPrefix}
+			}
+			group.versionCount++
+			if group.latestVersion == "" || semver.Compare(version, group.latestVersion) == 1 {
+				group.latestVersion = version
+				group.latestKey = key
+			}
+		}
+		if !res.More {
+			break
+		}
+		start = string(res.Kvs[len(res.Kvs)-1].Key) + "\x00"
+	}
+	if group != nil {
+		var err error
+		summaries, err = repo.appendSummary(ctx, summaries, group, revision, filter)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	return summaries, "", nil
+}
+
+func (repo *EtcdRepository) appendSummary(ctx context.Context, summaries []*pb.ConfigSchemaSummary, group *schemaGroup, revision int64, filter func(summary *pb.ConfigSchemaSummary) bool) ([]*pb.ConfigSchemaSummary, error) {
+	res, err := repo.client.Get(ctx, group.latestKey, clientv3.WithRev(revision))
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Kvs) == 0 {
+		return summaries, nil
+	}
+	var schemaData pb.ConfigSchemaData
+	if err := json.Unmarshal(res.Kvs[0].Value, &schemaData); err != nil {
+		return nil, err
+	}
+	schemaData.Schema = ""
+	summary := &pb.ConfigSchemaSummary{
+		SchemaDetails: GetSchemaDetailsFromKey(group.latestKey),
+		VersionCount:  group.versionCount,
+		SchemaData:    &schemaData,
+	}
+	if filter != nil && !filter(summary) {
+		return summaries, nil
+	}
+	return append(summaries, summary), nil
+}
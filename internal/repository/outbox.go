@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+const (
+	outboxPrefix     = "_outbox/"
+	oortSyncedPrefix = "_oort/"
+)
+
+// OutboxEntry is a pending request to an external system which is stored
+// in the same transaction as the schema change that produced it.
+type OutboxEntry struct {
+	Id          string    `json:"id"`
+	Kind        string    `json:"kind"`
+	SchemaKey   string    `json:"schema_key"`
+	Payload     []byte    `json:"payload"`
+	Attempts    int32     `json:"attempts"`
+	LastError   string    `json:"last_error,omitempty"`
+	NextAttempt time.Time `json:"next_attempt"`
+	CreatedAt   time.Time `json:"created_at"`
+	// TraceContext links the delivery to the trace of the request which produced the entry.
+	TraceContext map[string]string `json:"trace_context,omitempty"`
+}
+
+func NewOutboxEntry(kind string, schemaKey string, payload []byte) *OutboxEntry {
+	now := time.Now()
+	return &OutboxEntry{
+		Id:          fmt.Sprintf("%020d/%s/%s", nextSequence(now), kind, schemaKey),
+		Kind:        kind,
+		SchemaKey:   schemaKey,
+		Payload:     payload,
+		NextAttempt: now,
+		CreatedAt:   now,
+	}
+}
+
+func outboxPutOps(ctx context.Context, entries []*OutboxEntry) ([]clientv3.Op, error) {
+	ops := make([]clientv3.Op, len(entries))
+	for i, entry := range entries {
+		entry.TraceContext = make(map[string]string)
+		otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(entry.TraceContext))
+		serializedEntry, err := json.Marshal(entry)
+		if err != nil {
+			return nil, err
+		}
+		ops[i] = clientv3.OpPut(outboxPrefix+entry.Id, string(serializedEntry))
+	}
+	return ops, nil
+}
+
+func (repo *EtcdRepository) EnqueueOutboxEntries(ctx context.Context, entries ...*OutboxEntry) error {
+	ctx, done := repo.startOperation(ctx, "EnqueueOutboxEntries")
+	defer done()
+	ops, err := outboxPutOps(ctx, entries)
+	if err != nil {
+		return err
+	}
+	_, err = repo.client.Txn(ctx).Then(ops...).Commit()
+	return err
+}
+
+// GetOutboxEntries returns all undelivered entries in the order in which they were created.
+func (repo *EtcdRepository) GetOutboxEntries(ctx context.Context) ([]*OutboxEntry, error) {
+	ctx, done := repo.startOperation(ctx, "GetOutboxEntries")
+	defer done()
+	res, err := repo.client.Get(ctx, outboxPrefix, clientv3.WithPrefix(), clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]*OutboxEntry, len(res.Kvs))
+	for i, entryKv := range res.Kvs {
+		var entry OutboxEntry
+		if err := json.Unmarshal(entryKv.Value, &entry); err != nil {
+			return nil, err
+		}
+		entries[i] = &entry
+	}
+	return entries, nil
+}
+
+func (repo *EtcdRepository) UpdateOutboxEntry(ctx context.Context, entry *OutboxEntry) error {
+	ctx, done := repo.startOperation(ctx, "UpdateOutboxEntry")
+	defer done()
+	serializedEntry, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	_, err = repo.client.Put(ctx, outboxPrefix+entry.Id, string(serializedEntry))
+	return err
+}
+
+func (repo *EtcdRepository) CompleteOutboxEntry(ctx context.Context, entry *OutboxEntry) error {
+	ctx, done := repo.startOperation(ctx, "CompleteOutboxEntry")
+	defer done()
+	_, err := repo.client.Delete(ctx, outboxPrefix+entry.Id)
+	return err
+}
+
+// MarkOortSynced records that oort holds the relationships of the schema stored under the given key.
+func (repo *EtcdRepository) MarkOortSynced(ctx context.Context, schemaKey string) error {
+	ctx, done := repo.startOperation(ctx, "MarkOortSynced")
+	defer done()
+	_, err := repo.client.Put(ctx, oortSyncedPrefix+schemaKey, time.Now().Format(time.RFC3339))
+	return err
+}
+
+func (repo *EtcdRepository) GetOortSyncedKeys(ctx context.Context, prefix string) (map[string]bool, error) {
+	ctx, done := repo.startOperation(ctx, "GetOortSyncedKeys")
+	defer done()
+	res, err := repo.client.Get(ctx, oortSyncedPrefix+prefix, clientv3.WithPrefix(), clientv3.WithKeysOnly())
+	if err != nil {
+		return nil, err
+	}
+	syncedKeys := make(map[string]bool, len(res.Kvs))
+	for _, syncedKv := range res.Kvs {
+		syncedKeys[string(syncedKv.Key)[len(oortSyncedPrefix):]] = true
+	}
+	return syncedKeys, nil
+}
+
+func (repo *EtcdRepository) UnmarkOortSynced(ctx context.Context, schemaKey string) error {
+	ctx, done := repo.startOperation(ctx, "UnmarkOortSynced")
+	defer done()
+	_, err := repo.client.Delete(ctx, oortSyncedPrefix+schemaKey)
+	return err
+}
+
+// GetOrphanedOortKeys returns keys of schemas whose relationships oort has acknowledged, but which no longer
+// exist in etcd.
+// Schema keys are read without values and compared with the markers in memory.
+func (repo *EtcdRepository) GetOrphanedOortKeys(ctx context.Context) ([]string, error) {
+	syncedKeys, err := repo.GetOortSyncedKeys(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	_, err = repo.scanSchemaKeys(ctx, "GetOrphanedOortKeys", true, func(key string, _ []byte) error {
+		delete(syncedKeys, key)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	orphanedKeys := make([]string, 0, len(syncedKeys))
+	for key := range syncedKeys {
+		orphanedKeys = append(orphanedKeys, key)
+	}
+	sort.Strings(orphanedKeys)
+	return orphanedKeys, nil
+}
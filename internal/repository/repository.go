@@ -4,11 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"os"
 	"sort"
 	"strings"
+	"sync/atomic"
 	"time"
 
+	"github.com/jtomic1/config-schema-service/internal/certs"
+	"github.com/jtomic1/config-schema-service/internal/config"
+	"github.com/jtomic1/config-schema-service/internal/tracing"
+	"github.com/jtomic1/config-schema-service/pkg/validation"
 	pb "github.com/jtomic1/config-schema-service/proto"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"golang.org/x/mod/semver"
@@ -16,59 +20,126 @@ import (
 	"sigs.k8s.io/yaml"
 )
 
-var (
-	endpoint = os.Getenv("ETCD_ADDRESS")
-	timeout  = 5 * time.Second
-)
-
 type EtcdRepository struct {
-	client *clientv3.Client
+	client  *clientv3.Client
+	timeout time.Duration
+}
+
+var lastSequence atomic.Int64
+
+// nextSequence returns a strictly increasing timestamp, so that outbox entries and audit entries created within
+// the same nanosecond keep their creation order.
+func nextSequence(now time.Time) int64 {
+	for {
+		last := lastSequence.Load()
+		next := now.UnixNano()
+		if next <= last {
+			next = last + 1
+		}
+		if lastSequence.CompareAndSwap(last, next) {
+			return next
+		}
+	}
 }
 
-func NewClient() (*EtcdRepository, error) {
+// NewClient connects to etcd. Every method of the repository takes the context of its caller, and its operations
+// are traced as children of the span carried by that context and logged with the logger which it carries, so that
+// the operations performed on behalf of a request carry the fields of the request. Every etcd operation is also
+// measured.
+func NewClient(cfg config.EtcdConfig) (*EtcdRepository, error) {
+	tlsCfg, err := certs.ClientConfig(cfg.TLS)
+	if err != nil {
+		return &EtcdRepository{}, err
+	}
 	cli, err := clientv3.New(clientv3.Config{
-		Endpoints:   []string{endpoint},
-		DialTimeout: timeout,
+		Endpoints:   []string{cfg.Address},
+		DialTimeout: time.Duration(cfg.DialTimeout),
+		TLS:         tlsCfg,
 	})
-	return &EtcdRepository{
-		client: cli,
-	}, err
+	repo := &EtcdRepository{
+		client:  cli,
+		timeout: time.Duration(cfg.RequestTimeout),
+	}
+	if err != nil {
+		return repo, err
+	}
+	cli.KV = &instrumentedKV{KV: cli.KV}
+	return repo, nil
+}
+
+// startOperation starts the span of a repository method, whose etcd calls are bounded by the repository timeout.
+// Request cancellation is not propagated, so that a cancelled request does not abandon a started change.
+func (repo *EtcdRepository) startOperation(ctx context.Context, name string) (context.Context, func()) {
+	ctx, span := tracing.Tracer().Start(ctx, "EtcdRepository."+name)
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), repo.timeout)
+	return ctx, func() {
+		cancel()
+		span.End()
+	}
 }
 
 func (repo *EtcdRepository) Close() {
 	repo.client.Close()
 }
 
-func (repo *EtcdRepository) SaveConfigSchema(key string, schema string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	defer cancel()
-	res, err := repo.client.Get(ctx, key)
+// Ping checks that etcd can serve reads.
+func (repo *EtcdRepository) Ping(ctx context.Context) error {
+	ctx, done := repo.startOperation(ctx, "Ping")
+	defer done()
+	_, err := repo.client.Get(ctx, "_health", clientv3.WithCountOnly())
+	return err
+}
+
+// SaveConfigSchema stores the YAML schema together with its metadata. The creation time is set by the repository,
+// unless it is given. Imported schemas also carry their lifecycle and the origin recorded in the archive.
+func (repo *EtcdRepository) SaveConfigSchema(ctx context.Context, key string, schemaData *pb.ConfigSchemaData, outbox ...*OutboxEntry) error {
+	ctx, done := repo.startOperation(ctx, "SaveConfigSchema")
+	defer done()
+	schemaJson, err := validation.Normalize(schemaData.GetSchema())
 	if err != nil {
 		return err
 	}
-	if res.Count > 0 {
-		return errors.New("Key '" + key + "' already exists!")
+	creationTime := schemaData.GetCreationTime()
+	if creationTime == nil {
+		creationTime = timestamppb.New(time.Now())
+	}
+	storedData := &pb.ConfigSchemaData{
+		Schema:       string(schemaJson),
+		CreationTime: creationTime,
+		Description:  schemaData.GetDescription(),
+		CreatedBy:    schemaData.GetCreatedBy(),
+		Labels:       schemaData.GetLabels(),
+		Tags:         schemaData.GetTags(),
+		Lifecycle:    schemaData.GetLifecycle(),
+		ImportedFrom: schemaData.GetImportedFrom(),
 	}
-	schemaJson, err := yaml.YAMLToJSON([]byte(schema))
+	serializedData, err := json.Marshal(storedData)
 	if err != nil {
 		return err
 	}
-	schemaData := &pb.ConfigSchemaData{
-		Schema:       string(schemaJson),
-		CreationTime: timestamppb.New(time.Now()),
+	ops := []clientv3.Op{clientv3.OpPut(key, string(serializedData))}
+	outboxOps, err := outboxPutOps(ctx, outbox)
+	if err != nil {
+		return err
 	}
-	serializedData, err := json.Marshal(schemaData)
+	ops = append(ops, outboxOps...)
+	res, err := repo.client.Txn(ctx).
+		If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0)).
+		Then(ops...).
+		Commit()
 	if err != nil {
 		return err
 	}
-	_, err = repo.client.Put(ctx, key, string(serializedData))
-	return err
+	if !res.Succeeded {
+		return errors.New("Key '" + key + "' already exists!")
+	}
+	return nil
 }
 
-func (repo *EtcdRepository) GetConfigSchema(key string) (*pb.ConfigSchemaData, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (repo *EtcdRepository) GetConfigSchema(ctx context.Context, key string) (*pb.ConfigSchemaData, error) {
+	ctx, done := repo.startOperation(ctx, "GetConfigSchema")
 	resp, err := repo.client.Get(ctx, key)
-	cancel()
+	done()
 	if err != nil {
 		return nil, err
 	}
@@ -87,22 +158,56 @@ func (repo *EtcdRepository) GetConfigSchema(key string) (*pb.ConfigSchemaData, e
 	return &schemaData, nil
 }
 
-func (repo *EtcdRepository) DeleteConfigSchema(key string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	defer cancel()
-	res, err := repo.client.Delete(ctx, key)
-	if err != nil {
-		return err
-	}
-	if res.Deleted > 0 {
-		return nil
+// DeleteConfigSchema moves the schema into the tombstone keyspace, from which it can be restored until the retention expires.
+func (repo *EtcdRepository) DeleteConfigSchema(ctx context.Context, key string, deletedBy string, retention time.Duration, outbox ...*OutboxEntry) error {
+	ctx, done := repo.startOperation(ctx, "DeleteConfigSchema")
+	defer done()
+	for {
+		res, err := repo.client.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		if len(res.Kvs) == 0 {
+			return errors.New("No schema with key '" + key + "' found!")
+		}
+		var schemaData pb.ConfigSchemaData
+		if err := json.Unmarshal(res.Kvs[0].Value, &schemaData); err != nil {
+			return err
+		}
+		now := time.Now()
+		tombstone := &pb.DeletedConfigSchema{
+			SchemaDetails:  GetSchemaDetailsFromKey(key),
+			SchemaData:     &schemaData,
+			DeletedBy:      deletedBy,
+			DeletionTime:   timestamppb.New(now),
+			ExpirationTime: timestamppb.New(now.Add(retention)),
+		}
+		serializedTombstone, err := json.Marshal(tombstone)
+		if err != nil {
+			return err
+		}
+		ops := []clientv3.Op{clientv3.OpDelete(key), clientv3.OpPut(tombstonePrefix+key, string(serializedTombstone))}
+		outboxOps, err := outboxPutOps(ctx, outbox)
+		if err != nil {
+			return err
+		}
+		ops = append(ops, outboxOps...)
+		txnRes, err := repo.client.Txn(ctx).
+			If(clientv3.Compare(clientv3.ModRevision(key), "=", res.Kvs[0].ModRevision)).
+			Then(ops...).
+			Commit()
+		if err != nil {
+			return err
+		}
+		if txnRes.Succeeded {
+			return nil
+		}
 	}
-	return errors.New("No schema with key '" + key + "' found!")
 }
 
-func (repo *EtcdRepository) GetSchemasByPrefix(prefix string) ([]*pb.ConfigSchema, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	defer cancel()
+func (repo *EtcdRepository) GetSchemasByPrefix(ctx context.Context, prefix string) ([]*pb.ConfigSchema, error) {
+	ctx, done := repo.startOperation(ctx, "GetSchemasByPrefix")
+	defer done()
 	res, err := repo.client.Get(ctx, prefix, clientv3.WithPrefix())
 	if err != nil {
 		return nil, err
@@ -111,7 +216,7 @@ func (repo *EtcdRepository) GetSchemasByPrefix(prefix string) ([]*pb.ConfigSchem
 	}
 	schemas := make([]*pb.ConfigSchema, res.Count)
 	for i, schemaKv := range res.Kvs {
-		schemaDetails := getSchemaDetailsFromKey(string(schemaKv.Key))
+		schemaDetails := GetSchemaDetailsFromKey(string(schemaKv.Key))
 		var schemaData pb.ConfigSchemaData
 		if err := json.Unmarshal(schemaKv.Value, &schemaData); err != nil {
 			return nil, err
@@ -132,18 +237,177 @@ func (repo *EtcdRepository) GetSchemasByPrefix(prefix string) ([]*pb.ConfigSchem
 	return schemas, nil
 }
 
-func (repo *EtcdRepository) GetLatestVersionByPrefix(prefix string) (string, error) {
-	schemas, err := repo.GetSchemasByPrefix(prefix)
+// GetLatestVersionByPrefix returns the latest version under the prefix. Yanked versions are never considered latest.
+// Keys are read without values, and values are only read for the newest versions until one is not yanked, which
+// is usually the newest version itself.
+func (repo *EtcdRepository) GetLatestVersionByPrefix(ctx context.Context, prefix string) (string, error) {
+	ctx, done := repo.startOperation(ctx, "GetLatestVersionByPrefix")
+	defer done()
+	res, err := repo.client.Get(ctx, prefix, clientv3.WithPrefix(), clientv3.WithKeysOnly())
 	if err != nil {
 		return "", err
 	}
-	if len(schemas) == 0 {
-		return "", nil
+	keys := make([]string, len(res.Kvs))
+	for i, schemaKv := range res.Kvs {
+		keys[i] = string(schemaKv.Key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return semver.Compare(keyVersion(keys[i]), keyVersion(keys[j])) == 1
+	})
+	for _, key := range keys {
+		// the values are read at the revision of the keys, so that the result is a consistent snapshot
+		valueRes, err := repo.client.Get(ctx, key, clientv3.WithRev(res.Header.Revision))
+		if err != nil {
+			return "", err
+		}
+		if len(valueRes.Kvs) == 0 {
+			continue
+		}
+		var schemaData pb.ConfigSchemaData
+		if err := json.Unmarshal(valueRes.Kvs[0].Value, &schemaData); err != nil {
+			return "", err
+		}
+		if schemaData.GetLifecycle().GetState() != pb.ConfigSchemaState_YANKED {
+			return keyVersion(key), nil
+		}
+	}
+	return "", nil
+}
+
+// UpdateConfigSchema applies the update to the stored schema data and stores the result together with
+// the returned outbox entries. The update is retried if the schema is changed concurrently.
+// The returned boolean is false if there is no schema with the given key.
+func (repo *EtcdRepository) UpdateConfigSchema(ctx context.Context, key string, update func(schemaData *pb.ConfigSchemaData) ([]*OutboxEntry, error)) (bool, error) {
+	ctx, done := repo.startOperation(ctx, "UpdateConfigSchema")
+	defer done()
+	for {
+		res, err := repo.client.Get(ctx, key)
+		if err != nil {
+			return false, err
+		}
+		if len(res.Kvs) == 0 {
+			return false, nil
+		}
+		var schemaData pb.ConfigSchemaData
+		if err := json.Unmarshal(res.Kvs[0].Value, &schemaData); err != nil {
+			return true, err
+		}
+		outbox, err := update(&schemaData)
+		if err != nil {
+			return true, err
+		}
+		serializedData, err := json.Marshal(&schemaData)
+		if err != nil {
+			return true, err
+		}
+		ops := []clientv3.Op{clientv3.OpPut(key, string(serializedData))}
+		outboxOps, err := outboxPutOps(ctx, outbox)
+		if err != nil {
+			return true, err
+		}
+		ops = append(ops, outboxOps...)
+		txnRes, err := repo.client.Txn(ctx).
+			If(clientv3.Compare(clientv3.ModRevision(key), "=", res.Kvs[0].ModRevision)).
+			Then(ops...).
+			Commit()
+		if err != nil {
+			return true, err
+		}
+		if txnRes.Succeeded {
+			return true, nil
+		}
 	}
-	return schemas[len(schemas)-1].GetSchemaDetails().GetVersion(), nil
 }
 
-func getSchemaDetailsFromKey(key string) *pb.ConfigSchemaDetails {
+func (repo *EtcdRepository) GetSchemaDetailsByPrefix(ctx context.Context, prefix string) ([]*pb.ConfigSchemaDetails, error) {
+	ctx, done := repo.startOperation(ctx, "GetSchemaDetailsByPrefix")
+	defer done()
+	res, err := repo.client.Get(ctx, prefix, clientv3.WithPrefix(), clientv3.WithKeysOnly())
+	if err != nil {
+		return nil, err
+	}
+	schemaDetails := make([]*pb.ConfigSchemaDetails, len(res.Kvs))
+	for i, schemaKv := range res.Kvs {
+		schemaDetails[i] = GetSchemaDetailsFromKey(string(schemaKv.Key))
+	}
+	return schemaDetails, nil
+}
+
+// GetAllSchemas returns every stored schema, with schemas in JSON format, together with
+// the revision at which they were read. Schemas are read in batches at that revision, and the keys
+// which the service keeps under reserved prefixes are not read at all.
+func (repo *EtcdRepository) GetAllSchemas(ctx context.Context) ([]*pb.ConfigSchema, int64, error) {
+	var schemas []*pb.ConfigSchema
+	revision, err := repo.scanSchemaKeys(ctx, "GetAllSchemas", false, func(key string, value []byte) error {
+		var schemaData pb.ConfigSchemaData
+		if err := json.Unmarshal(value, &schemaData); err != nil {
+			return err
+		}
+		schemas = append(schemas, &pb.ConfigSchema{
+			SchemaDetails: GetSchemaDetailsFromKey(key),
+			SchemaData:    &schemaData,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return schemas, revision, nil
+}
+
+// schemaKeyRanges are the key ranges which can hold schemas. The keys which the service keeps for itself all
+// start with '_', so they are skipped without being read. The end "\x00" is the end of the keyspace.
+var schemaKeyRanges = [][2]string{{"\x00", "_"}, {"`", "\x00"}}
+
+// scanSchemaKeys reads every schema key, with its value unless keysOnly is set, and calls fn for each of them.
+// Keys are read in batches at a single revision, which is returned, and every batch has its own timeout, so
+// that the scan is not bounded by the size of the keyspace.
+func (repo *EtcdRepository) scanSchemaKeys(ctx context.Context, name string, keysOnly bool, fn func(key string, value []byte) error) (int64, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "EtcdRepository."+name)
+	defer span.End()
+	var revision int64
+	for _, keyRange := range schemaKeyRanges {
+		start := keyRange[0]
+		for {
+			opts := []clientv3.OpOption{clientv3.WithRange(keyRange[1]), clientv3.WithLimit(listBatchSize)}
+			if keysOnly {
+				opts = append(opts, clientv3.WithKeysOnly())
+			}
+			if revision > 0 {
+				opts = append(opts, clientv3.WithRev(revision))
+			}
+			batchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), repo.timeout)
+			res, err := repo.client.Get(batchCtx, start, opts...)
+			cancel()
+			if err != nil {
+				tracing.RecordError(span, err)
+				return 0, err
+			}
+			revision = res.Header.Revision
+			for _, kv := range res.Kvs {
+				if !isSchemaKey(string(kv.Key)) {
+					continue
+				}
+				if err := fn(string(kv.Key), kv.Value); err != nil {
+					return 0, err
+				}
+			}
+			if !res.More {
+				break
+			}
+			start = string(res.Kvs[len(res.Kvs)-1].Key) + "\x00"
+		}
+	}
+	return revision, nil
+}
+
+// isSchemaKey distinguishes schema keys (organization/namespace/name/version) from keys
+// which the service keeps under reserved prefixes.
+func isSchemaKey(key string) bool {
+	return !strings.HasPrefix(key, "_") && strings.Count(key, "/") == 3
+}
+
+func GetSchemaDetailsFromKey(key string) *pb.ConfigSchemaDetails {
 	tokens := strings.Split(key, "/")
 	return &pb.ConfigSchemaDetails{
 		Organization: tokens[0],
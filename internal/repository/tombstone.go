@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	pb "github.com/jtomic1/config-schema-service/proto"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const tombstonePrefix = "_tombstones/"
+
+// GetDeletedSchemas returns the deleted schemas under the prefix which can still be restored.
+// Schema values are omitted.
+func (repo *EtcdRepository) GetDeletedSchemas(ctx context.Context, prefix string) ([]*pb.DeletedConfigSchema, error) {
+	ctx, done := repo.startOperation(ctx, "GetDeletedSchemas")
+	defer done()
+	res, err := repo.client.Get(ctx, tombstonePrefix+prefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	deletedSchemas := make([]*pb.DeletedConfigSchema, 0, len(res.Kvs))
+	for _, tombstoneKv := range res.Kvs {
+		var tombstone pb.DeletedConfigSchema
+		if err := json.Unmarshal(tombstoneKv.Value, &tombstone); err != nil {
+			return nil, err
+		}
+		if tombstone.GetExpirationTime().AsTime().Before(time.Now()) {
+			continue
+		}
+		tombstone.SchemaData.Schema = ""
+		deletedSchemas = append(deletedSchemas, &tombstone)
+	}
+	return deletedSchemas, nil
+}
+
+// RestoreConfigSchema moves a deleted schema back from the tombstone keyspace, keeping its original data,
+// and stores the given outbox entries in the same transaction.
+func (repo *EtcdRepository) RestoreConfigSchema(ctx context.Context, key string, outbox ...*OutboxEntry) error {
+	ctx, done := repo.startOperation(ctx, "RestoreConfigSchema")
+	defer done()
+	res, err := repo.client.Get(ctx, tombstonePrefix+key)
+	if err != nil {
+		return err
+	}
+	if len(res.Kvs) == 0 {
+		return errors.New("No deleted schema with key '" + key + "' found!")
+	}
+	var tombstone pb.DeletedConfigSchema
+	if err := json.Unmarshal(res.Kvs[0].Value, &tombstone); err != nil {
+		return err
+	}
+	if tombstone.GetExpirationTime().AsTime().Before(time.Now()) {
+		return errors.New("No deleted schema with key '" + key + "' found!")
+	}
+	serializedData, err := json.Marshal(tombstone.GetSchemaData())
+	if err != nil {
+		return err
+	}
+	ops := []clientv3.Op{clientv3.OpPut(key, string(serializedData)), clientv3.OpDelete(tombstonePrefix + key)}
+	outboxOps, err := outboxPutOps(ctx, outbox)
+	if err != nil {
+		return err
+	}
+	ops = append(ops, outboxOps...)
+	txnRes, err := repo.client.Txn(ctx).
+		If(
+			clientv3.Compare(clientv3.CreateRevision(key), "=", 0),
+			clientv3.Compare(clientv3.ModRevision(tombstonePrefix+key), "=", res.Kvs[0].ModRevision),
+		).
+		Then(ops...).
+		Commit()
+	if err != nil {
+		return err
+	}
+	if !txnRes.Succeeded {
+		return errors.New("Key '" + key + "' already exists or has been changed concurrently!")
+	}
+	return nil
+}
+
+// PurgeExpiredTombstones permanently deletes the schemas whose retention has expired and returns their number.
+func (repo *EtcdRepository) PurgeExpiredTombstones(ctx context.Context) (int, error) {
+	ctx, done := repo.startOperation(ctx, "PurgeExpiredTombstones")
+	defer done()
+	res, err := repo.client.Get(ctx, tombstonePrefix, clientv3.WithPrefix())
+	if err != nil {
+		return 0, err
+	}
+	purged := 0
+	for _, tombstoneKv := range res.Kvs {
+		var tombstone pb.DeletedConfigSchema
+		if err := json.Unmarshal(tombstoneKv.Value, &tombstone); err != nil {
+			return purged, err
+		}
+		if tombstone.GetExpirationTime().AsTime().After(time.Now()) {
+			continue
+		}
+		// the tombstone is only purged if it has not been replaced by a newer deletion in the meantime
+		txnRes, err := repo.client.Txn(ctx).
+			If(clientv3.Compare(clientv3.ModRevision(string(tombstoneKv.Key)), "=", tombstoneKv.ModRevision)).
+			Then(clientv3.OpDelete(string(tombstoneKv.Key))).
+			Commit()
+		if err != nil {
+			return purged, err
+		}
+		if txnRes.Succeeded {
+			purged++
+		}
+	}
+	return purged, nil
+}
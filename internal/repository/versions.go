@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	pb "github.com/jtomic1/config-schema-service/proto"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"golang.org/x/mod/semver"
+	"sigs.k8s.io/yaml"
+)
+
+// maxTxnGets stays below the default limit of operations in a single etcd transaction.
+const maxTxnGets = 100
+
+// VersionsQuery describes which versions of a schema GetSchemaVersions returns, and in which order.
+type VersionsQuery struct {
+	Descending bool
+	// Contains selects versions by their version string, before any value is read.
+	Contains func(version string) bool
+	// Filter selects versions by their metadata.
+	Filter func(schema *pb.ConfigSchema) bool
+	// StartAfter is the last version of the previous page.
+	StartAfter string
+	// PageSize limits the number of returned versions. Zero means no limit.
+	PageSize    int
+	SummaryOnly bool
+}
+
+// GetSchemaVersions returns a page of versions of the schema stored under the prefix. Versions are selected
+// and sorted using keys only, so values are read just for the versions which end up on the page.
+// The returned string is the last returned version if there are more versions to return, and empty otherwise.
+func (repo *EtcdRepository) GetSchemaVersions(ctx context.Context, prefix string, query VersionsQuery) ([]*pb.ConfigSchema, string, error) {
+	ctx, done := repo.startOperation(ctx, "GetSchemaVersions")
+	defer done()
+	res, err := repo.client.Get(ctx, prefix, clientv3.WithPrefix(), clientv3.WithKeysOnly())
+	if err != nil {
+		return nil, "", err
+	}
+	revision := res.Header.Revision
+	keys := make([]string, 0, len(res.Kvs))
+	for _, kv := range res.Kvs {
+		key := string(kv.Key)
+		version := keyVersion(key)
+		if query.Contains != nil && !query.Contains(version) {
+			continue
+		}
+		if query.StartAfter != "" && !versionFollows(version, query.StartAfter, query.Descending) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return versionFollows(keyVersion(keys[j]), keyVersion(keys[i]), query.Descending)
+	})
+
+	batchSize := maxTxnGets
+	if query.PageSize > 0 && query.PageSize < batchSize {
+		batchSize = query.PageSize
+	}
+	var schemas []*pb.ConfigSchema
+	for start := 0; start < len(keys); start += batchSize {
+		end := min(start+batchSize, len(keys))
+		batch, err := repo.getSchemasByKeys(ctx, keys[start:end], revision, query.SummaryOnly)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, schema := range batch {
+			if query.Filter != nil && !query.Filter(schema) {
+				continue
+			}
+			schemas = append(schemas, schema)
+			if query.PageSize > 0 && len(schemas) == query.PageSize {
+				lastVersion := schema.GetSchemaDetails().GetVersion()
+				if lastVersion != keyVersion(keys[len(keys)-1]) {
+					return schemas, lastVersion, nil
+				}
+				return schemas, "", nil
+			}
+		}
+	}
+	return schemas, "", nil
+}
+
+// getSchemasByKeys reads the given keys in a single transaction, keeping their order.
+func (repo *EtcdRepository) getSchemasByKeys(ctx context.Context, keys []string, revision int64, summaryOnly bool) ([]*pb.ConfigSchema, error) {
+	ops := make([]clientv3.Op, len(keys))
+	for i, key := range keys {
+		ops[i] = clientv3.OpGet(key, clientv3.WithRev(revision))
+	}
+	res, err := repo.client.Txn(ctx).Then(ops...).Commit()
+	if err != nil {
+		return nil, err
+	}
+	schemas := make([]*pb.ConfigSchema, 0, len(keys))
+	for i, opRes := range res.Responses {
+		kvs := opRes.GetResponseRange().GetKvs()
+		if len(kvs) == 0 {
+			continue
+		}
+		var schemaData pb.ConfigSchemaData
+		if err := json.Unmarshal(kvs[0].Value, &schemaData); err != nil {
+			return nil, err
+		}
+		if summaryOnly {
+			schemaData.Schema = ""
+		} else {
+			schemaYaml, err := yaml.JSONToYAML([]byte(schemaData.GetSchema()))
+			if err != nil {
+				return nil, err
+			}
+			schemaData.Schema = string(schemaYaml)
+		}
+		schemas = append(schemas, &pb.ConfigSchema{
+			SchemaDetails: GetSchemaDetailsFromKey(keys[i]),
+			SchemaData:    &schemaData,
+		})
+	}
+	return schemas, nil
+}
+
+// versionFollows reports whether version comes after previous in the given order.
+func versionFollows(version string, previous string, descending bool) bool {
+	if descending {
+		return semver.Compare(version, previous) == -1
+	}
+	return semver.Compare(version, previous) == 1
+}
+
+func keyVersion(key string) string {
+	return key[strings.LastIndex(key, "/")+1:]
+}
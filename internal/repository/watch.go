@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	pb "github.com/jtomic1/config-schema-service/proto"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// SchemaChange describes a single change of a schema. SchemaData holds the stored value,
+// with the schema in JSON format, and is nil for deleted schemas.
+type SchemaChange struct {
+	EventType     pb.ConfigSchemaEventType
+	SchemaDetails *pb.ConfigSchemaDetails
+	SchemaData    *pb.ConfigSchemaData
+	Revision      int64
+}
+
+// ErrRevisionCompacted is returned by WatchSchemas when the requested start revision is no longer available.
+type ErrRevisionCompacted struct {
+	CompactRevision int64
+}
+
+func (e *ErrRevisionCompacted) Error() string {
+	return fmt.Sprintf("revision has been compacted, oldest available revision is %d", e.CompactRevision)
+}
+
+// WatchSchemas calls handle for every change of a schema under the prefix, starting from the given revision
+// (or from the current one if the revision is 0). It blocks until the context is done or handle returns an error.
+func (repo *EtcdRepository) WatchSchemas(ctx context.Context, prefix string, startRevision int64, handle func(change *SchemaChange) error) error {
+	ctx, cancel := context.WithCancel(clientv3.WithRequireLeader(ctx))
+	defer cancel()
+	opts := []clientv3.OpOption{clientv3.WithPrefix()}
+	if startRevision > 0 {
+		opts = append(opts, clientv3.WithRev(startRevision))
+	}
+	for watchResp := range repo.client.Watch(ctx, prefix, opts...) {
+		if watchResp.CompactRevision != 0 {
+			return &ErrRevisionCompacted{CompactRevision: watchResp.CompactRevision}
+		}
+		if err := watchResp.Err(); err != nil {
+			return err
+		}
+		for _, event := range watchResp.Events {
+			if !isSchemaKey(string(event.Kv.Key)) {
+				continue
+			}
+			change := &SchemaChange{
+				SchemaDetails: GetSchemaDetailsFromKey(string(event.Kv.Key)),
+				Revision:      event.Kv.ModRevision,
+			}
+			switch {
+			case event.Type == clientv3.EventTypeDelete:
+				change.EventType = pb.ConfigSchemaEventType_DELETED
+			case event.IsCreate():
+				change.EventType = pb.ConfigSchemaEventType_CREATED
+			default:
+				change.EventType = pb.ConfigSchemaEventType_UPDATED
+			}
+			if event.Type != clientv3.EventTypeDelete {
+				var schemaData pb.ConfigSchemaData
+				if err := json.Unmarshal(event.Kv.Value, &schemaData); err != nil {
+					return err
+				}
+				change.SchemaData = &schemaData
+			}
+			if err := handle(change); err != nil {
+				return err
+			}
+		}
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,284 @@
+package search
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	pb "github.com/jtomic1/config-schema-service/proto"
+)
+
+// document holds everything which is indexed for a single schema version.
+type document struct {
+	details    *pb.ConfigSchemaDetails
+	terms      []string
+	properties map[string]map[string]string
+}
+
+type keySet map[string]struct{}
+
+// Index is an in-memory inverted index of schema names, descriptions and property paths,
+// as well as of the keywords (e.g. "format: uri") which are used by the properties.
+type Index struct {
+	mu         sync.RWMutex
+	documents  map[string]*document
+	terms      map[string]keySet
+	properties map[string]keySet
+	keywords   map[string]keySet
+}
+
+type Query struct {
+	// Prefix limits the search to schemas whose keys start with it.
+	Prefix string
+	// Text must be contained in the schema name or description, word by word.
+	Text string
+	// PropertyPath is a dot-separated path of a property which a schema must define.
+	PropertyPath string
+	// Keywords must all be used by the same property, which is PropertyPath if given.
+	Keywords   map[string]string
+	MaxResults int
+}
+
+func NewIndex() *Index {
+	idx := &Index{}
+	idx.reset()
+	return idx
+}
+
+func (idx *Index) reset() {
+	idx.documents = make(map[string]*document)
+	idx.terms = make(map[string]keySet)
+	idx.properties = make(map[string]keySet)
+	idx.keywords = make(map[string]keySet)
+}
+
+// Replace discards the contents of the index and indexes the given schemas instead.
+func (idx *Index) Replace(schemas []*pb.ConfigSchema) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.reset()
+	for _, schema := range schemas {
+		idx.add(schema.GetSchemaDetails(), schema.GetSchemaData())
+	}
+}
+
+// Put indexes a schema version, replacing the previous document with the same key. The schema must be in JSON format.
+func (idx *Index) Put(details *pb.ConfigSchemaDetails, schemaData *pb.ConfigSchemaData) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(schemaKey(details))
+	idx.add(details, schemaData)
+}
+
+func (idx *Index) Delete(details *pb.ConfigSchemaDetails) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(schemaKey(details))
+}
+
+func (idx *Index) add(details *pb.ConfigSchemaDetails, schemaData *pb.ConfigSchemaData) {
+	key := schemaKey(details)
+	doc := &document{
+		details:    details,
+		terms:      tokenize(details.GetSchemaName() + " " + schemaData.GetDescription()),
+		properties: make(map[string]map[string]string),
+	}
+	var schema map[string]interface{}
+	if err := json.Unmarshal([]byte(schemaData.GetSchema()), &schema); err == nil {
+		collectProperties(schema, "", doc.properties)
+	}
+	idx.documents[key] = doc
+	for _, term := range doc.terms {
+		addKey(idx.terms, term, key)
+	}
+	for path, keywords := range doc.properties {
+		addKey(idx.properties, path, key)
+		for keyword, value := range keywords {
+			addKey(idx.keywords, keywordTerm(keyword, value), key)
+		}
+	}
+}
+
+func (idx *Index) remove(key string) {
+	doc, ok := idx.documents[key]
+	if !ok {
+		return
+	}
+	delete(idx.documents, key)
+	for _, term := range doc.terms {
+		removeKey(idx.terms, term, key)
+	}
+	for path, keywords := range doc.properties {
+		removeKey(idx.properties, path, key)
+		for keyword, value := range keywords {
+			removeKey(idx.keywords, keywordTerm(keyword, value), key)
+		}
+	}
+}
+
+// Search returns schemas which match every part of the query, sorted by key,
+// together with the paths of the properties which matched the structural part of the query.
+func (idx *Index) Search(query Query) ([]*pb.ConfigSchemaDetails, [][]string) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	var candidates []keySet
+	for _, term := range tokenize(query.Text) {
+		candidates = append(candidates, idx.terms[term])
+	}
+	if query.PropertyPath != "" {
+		candidates = append(candidates, idx.properties[query.PropertyPath])
+	}
+	for keyword, value := range query.Keywords {
+		candidates = append(candidates, idx.keywords[keywordTerm(keyword, value)])
+	}
+
+	var keys []string
+	if len(candidates) == 0 {
+		for key := range idx.documents {
+			keys = append(keys, key)
+		}
+	} else {
+		sort.Slice(candidates, func(i, j int) bool { return len(candidates[i]) < len(candidates[j]) })
+		for key := range candidates[0] {
+			if containsAll(candidates[1:], key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+
+	var results []*pb.ConfigSchemaDetails
+	var matchedProperties [][]string
+	for _, key := range keys {
+		if !strings.HasPrefix(key, query.Prefix) {
+			continue
+		}
+		doc := idx.documents[key]
+		matched := doc.matchProperties(query.PropertyPath, query.Keywords)
+		if (query.PropertyPath != "" || len(query.Keywords) > 0) && len(matched) == 0 {
+			continue
+		}
+		results = append(results, doc.details)
+		matchedProperties = append(matchedProperties, matched)
+		if query.MaxResults > 0 && len(results) == query.MaxResults {
+			break
+		}
+	}
+	return results, matchedProperties
+}
+
+// matchProperties returns the properties which are at the given path (if any) and use all of the given keywords.
+func (doc *document) matchProperties(path string, keywords map[string]string) []string {
+	if path == "" && len(keywords) == 0 {
+		return nil
+	}
+	var matched []string
+	for propertyPath, propertyKeywords := range doc.properties {
+		if path != "" && propertyPath != path {
+			continue
+		}
+		ok := true
+		for keyword, value := range keywords {
+			if propertyKeywords[keyword] != value {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			matched = append(matched, propertyPath)
+		}
+	}
+	sort.Strings(matched)
+	return matched
+}
+
+// collectProperties walks the JSON schema and records the dot-separated path of every property,
+// together with its scalar keywords. Array items are denoted with "[]".
+func collectProperties(schema map[string]interface{}, path string, properties map[string]map[string]string) {
+	if nested, ok := schema["properties"].(map[string]interface{}); ok {
+		for name, child := range nested {
+			childSchema, ok := child.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			childPath := name
+			if path != "" {
+				childPath = path + "." + name
+			}
+			keywords := make(map[string]string)
+			for keyword, value := range childSchema {
+				switch value.(type) {
+				case string, bool, float64:
+					keywords[keyword] = fmt.Sprint(value)
+				}
+			}
+			properties[childPath] = keywords
+			collectProperties(childSchema, childPath, properties)
+		}
+	}
+	if items, ok := schema["items"].(map[string]interface{}); ok {
+		collectProperties(items, path+"[]", properties)
+	}
+	for _, combinator := range []string{"allOf", "anyOf", "oneOf"} {
+		if subschemas, ok := schema[combinator].([]interface{}); ok {
+			for _, subschema := range subschemas {
+				if subschemaMap, ok := subschema.(map[string]interface{}); ok {
+					collectProperties(subschemaMap, path, properties)
+				}
+			}
+		}
+	}
+}
+
+// tokenize splits text into lowercase words, treating every character other than a letter or a digit as a separator.
+func tokenize(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	seen := make(map[string]bool, len(words))
+	var terms []string
+	for _, word := range words {
+		if !seen[word] {
+			seen[word] = true
+			terms = append(terms, word)
+		}
+	}
+	return terms
+}
+
+func keywordTerm(keyword string, value string) string {
+	return keyword + "=" + value
+}
+
+func schemaKey(details *pb.ConfigSchemaDetails) string {
+	return details.GetOrganization() + "/" + details.GetNamespace() + "/" + details.GetSchemaName() + "/" + details.GetVersion()
+}
+
+func addKey(sets map[string]keySet, term string, key string) {
+	set, ok := sets[term]
+	if !ok {
+		set = make(keySet)
+		sets[term] = set
+	}
+	set[key] = struct{}{}
+}
+
+func removeKey(sets map[string]keySet, term string, key string) {
+	set := sets[term]
+	delete(set, key)
+	if len(set) == 0 {
+		delete(sets, term)
+	}
+}
+
+func containsAll(sets []keySet, key string) bool {
+	for _, set := range sets {
+		if _, ok := set[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,46 @@
+package search
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	pb "github.com/jtomic1/config-schema-service/proto"
+)
+
+const resyncDelay = 5 * time.Second
+
+// Sync builds the index from etcd and keeps it current by watching schema changes. Whenever the watch fails,
+// the index is rebuilt from a fresh snapshot. Sync blocks until the context is cancelled.
+func (idx *Index) Sync(ctx context.Context, repo *repository.EtcdRepository) {
+	for {
+		err := idx.syncOnce(ctx, repo)
+		if ctx.Err() != nil {
+			return
+		}
+		slog.Warn("search index synchronization interrupted, rebuilding", "error", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(resyncDelay):
+		}
+	}
+}
+
+func (idx *Index) syncOnce(ctx context.Context, repo *repository.EtcdRepository) error {
+	schemas, revision, err := repo.GetAllSchemas(ctx)
+	if err != nil {
+		return err
+	}
+	idx.Replace(schemas)
+	slog.Info("search index built", "schemas", len(schemas), "revision", revision)
+	return repo.WatchSchemas(ctx, "", revision+1, func(change *repository.SchemaChange) error {
+		if change.EventType == pb.ConfigSchemaEventType_DELETED {
+			idx.Delete(change.SchemaDetails)
+		} else {
+			idx.Put(change.SchemaDetails, change.SchemaData)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,70 @@
+package semverrange
+
+import (
+	"errors"
+	"strings"
+
+	"golang.org/x/mod/semver"
+)
+
+type comparator struct {
+	operator string
+	version  string
+}
+
+// Range is a set of comparators, all of which a version must satisfy, e.g. ">=v1.2.0 <v2.0.0".
+type Range struct {
+	comparators []comparator
+}
+
+var operators = []string{">=", "<=", ">", "<", "="}
+
+// Parse parses a space-separated list of comparators. Each comparator consists of an operator
+// (>=, <=, >, < or =) followed by a SemVer string with 'v' prefix. An empty string matches every version.
+func Parse(versionRange string) (*Range, error) {
+	r := &Range{}
+	for _, token := range strings.Fields(versionRange) {
+		c, err := parseComparator(token)
+		if err != nil {
+			return nil, err
+		}
+		r.comparators = append(r.comparators, c)
+	}
+	return r, nil
+}
+
+func parseComparator(token string) (comparator, error) {
+	for _, operator := range operators {
+		if strings.HasPrefix(token, operator) {
+			version := token[len(operator):]
+			if !semver.IsValid(version) {
+				return comparator{}, errors.New("version range must consist of operators followed by valid SemVer strings with 'v' prefix")
+			}
+			return comparator{operator: operator, version: version}, nil
+		}
+	}
+	return comparator{}, errors.New("version range comparator '" + token + "' must start with one of >=, <=, >, < or =")
+}
+
+func (r *Range) Contains(version string) bool {
+	for _, c := range r.comparators {
+		result := semver.Compare(version, c.version)
+		var ok bool
+		switch c.operator {
+		case ">=":
+			ok = result >= 0
+		case "<=":
+			ok = result <= 0
+		case ">":
+			ok = result > 0
+		case "<":
+			ok = result < 0
+		case "=":
+			ok = result == 0
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,61 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"sync"
+
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	pb "github.com/jtomic1/config-schema-service/proto"
+)
+
+const PermAuditGet = "audit.get"
+
+// AuditLogger records audit entries in etcd and, if a file is configured, appends them to it as JSON lines.
+type AuditLogger struct {
+	repo *repository.EtcdRepository
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewAuditLogger creates the audit logger. The file sink is disabled when filePath is empty.
+func NewAuditLogger(repo *repository.EtcdRepository, filePath string) (*AuditLogger, error) {
+	auditor := &AuditLogger{repo: repo}
+	if filePath != "" {
+		file, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, err
+		}
+		auditor.file = file
+	}
+	return auditor, nil
+}
+
+// Record stores the entry in every sink. Failures are logged, so that auditing never fails the audited operation.
+func (a *AuditLogger) Record(ctx context.Context, entry *pb.AuditLogEntry) {
+	if err := a.repo.AppendAuditEntry(ctx, entry); err != nil {
+		slog.Error("error while storing audit entry", "request_id", entry.GetRequestId(), "error", err)
+	}
+	if a.file == nil {
+		return
+	}
+	serializedEntry, err := json.Marshal(entry)
+	if err != nil {
+		slog.Error("error while serializing audit entry", "request_id", entry.GetRequestId(), "error", err)
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, err := a.file.Write(append(serializedEntry, '\n')); err != nil {
+		slog.Error("error while writing audit entry", "request_id", entry.GetRequestId(), "error", err)
+	}
+}
+
+func (a *AuditLogger) Close() error {
+	if a.file == nil {
+		return nil
+	}
+	return a.file.Close()
+}
@@ -2,11 +2,19 @@ package services
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
-	"log"
+	"net"
 	"strings"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/jtomic1/config-schema-service/internal/config"
+	"github.com/jtomic1/config-schema-service/internal/logging"
+	"github.com/jtomic1/config-schema-service/internal/metrics"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 const (
@@ -26,37 +34,136 @@ func OortSchemaId(org, namespace, name, version string) string {
 }
 
 type AuthZService struct {
-	key string
+	key                    string
+	certificatePermissions map[string][]string
 }
 
-func NewAuthZService(key string) *AuthZService {
-	return &AuthZService{key: key}
+func NewAuthZService(cfg config.AuthConfig) *AuthZService {
+	return &AuthZService{
+		key:                    cfg.SecretKey,
+		certificatePermissions: cfg.CertificatePermissions,
+	}
 }
 
-func (s *AuthZService) Authorize(ctx context.Context, permName string, objKind string, objId string) bool {
+func (s *AuthZService) parseToken(ctx context.Context) (*jwt.Token, error) {
 	tokenString, ok := ctx.Value("authz-token").(string)
 	if !ok {
-		log.Println("no token provided")
-		return false
+		return nil, errors.New("no token provided")
 	}
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+	return jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 		return []byte(s.key), nil
 	})
+}
+
+// CertificateIdentityMetadata carries the certificate identity of REST callers from the gateway to the in-memory
+// server behind it. It is only read from calls which come from the gateway.
+const CertificateIdentityMetadata = "x-certificate-identity"
+
+// certificateIdentity returns the identity of the verified client certificate of the caller. Calls forwarded by
+// the REST gateway carry the identity of the certificate which the HTTP client presented to the gateway.
+func certificateIdentity(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	switch authInfo := p.AuthInfo.(type) {
+	case credentials.TLSInfo:
+		return CertificateIdentity(authInfo.State)
+	case gatewayAuthInfo:
+		md, _ := metadata.FromIncomingContext(ctx)
+		identities := md.Get(CertificateIdentityMetadata)
+		if len(identities) != 1 || identities[0] == "" {
+			return "", false
+		}
+		return identities[0], true
+	default:
+		return "", false
+	}
+}
+
+// CertificateIdentity returns the identity of the verified client certificate of a TLS connection: its first URI
+// SAN, such as a SPIFFE ID, or its common name if it has none.
+func CertificateIdentity(state tls.ConnectionState) (string, bool) {
+	if len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+	cert := state.VerifiedChains[0][0]
+	if len(cert.URIs) > 0 {
+		return cert.URIs[0].String(), true
+	}
+	return cert.Subject.CommonName, cert.Subject.CommonName != ""
+}
+
+// gatewayAuthInfo identifies the connections of the REST gateway to the in-memory server behind it.
+type gatewayAuthInfo struct {
+	credentials.CommonAuthInfo
+}
+
+func (gatewayAuthInfo) AuthType() string {
+	return "gateway"
+}
+
+// gatewayCredentials accepts the in-memory connections of the REST gateway, which are trusted to carry the
+// certificate identity of the HTTP client.
+type gatewayCredentials struct{}
+
+// GatewayCredentials returns the credentials of the in-memory server behind the REST gateway. They must not be
+// used by a server which accepts connections from anywhere else.
+func GatewayCredentials() credentials.TransportCredentials {
+	return gatewayCredentials{}
+}
+
+func (gatewayCredentials) ClientHandshake(_ context.Context, _ string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return conn, gatewayAuthInfo{CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.NoSecurity}}, nil
+}
+
+func (gatewayCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return conn, gatewayAuthInfo{CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.NoSecurity}}, nil
+}
+
+func (gatewayCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: "gateway"}
+}
+
+func (c gatewayCredentials) Clone() credentials.TransportCredentials {
+	return c
+}
+
+func (gatewayCredentials) OverrideServerName(string) error {
+	return nil
+}
+
+func hasToken(ctx context.Context) bool {
+	_, ok := ctx.Value("authz-token").(string)
+	return ok
+}
+
+// Subject returns the identity of the caller, taken from the subject claim of the token. Callers without
+// a token are identified by their client certificate.
+func (s *AuthZService) Subject(ctx context.Context) (string, error) {
+	if !hasToken(ctx) {
+		if identity, ok := certificateIdentity(ctx); ok {
+			return identity, nil
+		}
+	}
+	token, err := s.parseToken(ctx)
 	if err != nil {
-		log.Printf("Error parsing token: %v", err)
+		return "", err
+	}
+	return token.Claims.GetSubject()
+}
+
+func (s *AuthZService) Authorize(ctx context.Context, permName string, objKind string, objId string) bool {
+	if !s.authorize(ctx, permName, objKind, objId) {
+		metrics.ObserveAuthorizationDenial(permName)
 		return false
 	}
+	return true
+}
 
-	var permissions []string
-	if claims, ok := token.Claims.(jwt.MapClaims); ok {
-		if permissionsClaim, ok := claims["permissions"].(string); ok {
-			permissions = strings.Split(permissionsClaim, ",")
-		} else {
-			log.Println("Custom Claim permissions is not a string or does not exist.")
-			return false
-		}
-	} else {
-		log.Println("Invalid claims type.")
+func (s *AuthZService) authorize(ctx context.Context, permName string, objKind string, objId string) bool {
+	permissions, ok := s.permissions(ctx)
+	if !ok {
 		return false
 	}
 
@@ -67,6 +174,39 @@ func (s *AuthZService) Authorize(ctx context.Context, permName string, objKind s
 		}
 	}
 
-	log.Println("required permission not found")
+	logging.FromContext(ctx).Info("permission denied", "permission", permName, "object_kind", objKind, "object_id", objId)
 	return false
 }
+
+// permissions returns the permissions of the caller. A token takes precedence over the client certificate,
+// whose permissions are configured per identity.
+func (s *AuthZService) permissions(ctx context.Context) ([]string, bool) {
+	if !hasToken(ctx) {
+		if identity, ok := certificateIdentity(ctx); ok {
+			permissions, ok := s.certificatePermissions[identity]
+			if !ok {
+				logging.FromContext(ctx).Warn("no permissions are configured for the client certificate", "identity", identity)
+			}
+			return permissions, ok
+		}
+	}
+	token, err := s.parseToken(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Warn("invalid token", "error", err)
+		return nil, false
+	}
+
+	var permissions []string
+	if claims, ok := token.Claims.(jwt.MapClaims); ok {
+		if permissionsClaim, ok := claims["permissions"].(string); ok {
+			permissions = strings.Split(permissionsClaim, ",")
+		} else {
+			logging.FromContext(ctx).Warn("permissions claim is not a string or does not exist")
+			return nil, false
+		}
+	} else {
+		logging.FromContext(ctx).Warn("invalid claims type")
+		return nil, false
+	}
+	return permissions, true
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jtomic1/config-schema-service/internal/config"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestCertificateIdentityIsOnlyTrustedFromTheGateway(t *testing.T) {
+	authorizer := NewAuthZService(config.AuthConfig{
+		CertificatePermissions: map[string][]string{
+			"spiffe://c12s/deployer": {PermSchemaGet + "|" + OortResSchema + "|c12s/prod/database/v1.0.0"},
+		},
+	})
+	_, gatewayAuth, _ := GatewayCredentials().ServerHandshake(nil)
+	_, insecureAuth, _ := insecure.NewCredentials().ServerHandshake(nil)
+
+	tests := []struct {
+		name       string
+		peer       *peer.Peer
+		md         metadata.MD
+		authorized bool
+	}{
+		{
+			name:       "gateway",
+			peer:       &peer.Peer{AuthInfo: gatewayAuth},
+			md:         metadata.Pairs(CertificateIdentityMetadata, "spiffe://c12s/deployer"),
+			authorized: true,
+		},
+		{
+			name: "gateway without a certificate",
+			peer: &peer.Peer{AuthInfo: gatewayAuth},
+			md:   metadata.MD{},
+		},
+		{
+			name: "gateway with more than one identity",
+			peer: &peer.Peer{AuthInfo: gatewayAuth},
+			md:   metadata.Pairs(CertificateIdentityMetadata, "spiffe://c12s/deployer", CertificateIdentityMetadata, "spiffe://c12s/other"),
+		},
+		{
+			name: "public connection",
+			peer: &peer.Peer{AuthInfo: insecureAuth},
+			md:   metadata.Pairs(CertificateIdentityMetadata, "spiffe://c12s/deployer"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := peer.NewContext(metadata.NewIncomingContext(context.Background(), tt.md), tt.peer)
+			authorized := authorizer.Authorize(ctx, PermSchemaGet, OortResSchema, "c12s/prod/database/v1.0.0")
+			if authorized != tt.authorized {
+				t.Errorf("expected authorized to be %v, got %v", tt.authorized, authorized)
+			}
+			subject, _ := authorizer.Subject(ctx)
+			if tt.authorized && subject != "spiffe://c12s/deployer" {
+				t.Errorf("expected subject spiffe://c12s/deployer, got %q", subject)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const OutboxKindSchemaEvent = "nats.schema_event"
+
+// SchemaEventVersion is incremented whenever the SchemaEvent message changes incompatibly.
+const SchemaEventVersion = 1
+
+const schemaEventFlushTimeout = 5 * time.Second
+
+func SchemaEventSubject(org, namespace, name string) string {
+	return fmt.Sprintf("quasar.%s.%s.%s", org, namespace, name)
+}
+
+// SchemaEventPublisher publishes schema change events stored in the outbox to NATS.
+// Events are delivered at least once, so consumers should deduplicate them by event id.
+type SchemaEventPublisher struct {
+	conn *nats.Conn
+}
+
+func NewSchemaEventPublisher(conn *nats.Conn) *SchemaEventPublisher {
+	return &SchemaEventPublisher{conn: conn}
+}
+
+func (p *SchemaEventPublisher) Register(worker *OutboxWorker) {
+	worker.Handle(OutboxKindSchemaEvent, p.publish)
+}
+
+func NewSchemaCreatedEntry(details *pb.ConfigSchemaDetails) (*repository.OutboxEntry, error) {
+	return newSchemaEventEntry(details, &pb.SchemaEvent{
+		Event: &pb.SchemaEvent_Created{Created: &pb.SchemaCreated{SchemaDetails: details}},
+	})
+}
+
+func NewSchemaDeletedEntry(details *pb.ConfigSchemaDetails) (*repository.OutboxEntry, error) {
+	return newSchemaEventEntry(details, &pb.SchemaEvent{
+		Event: &pb.SchemaEvent_Deleted{Deleted: &pb.SchemaDeleted{SchemaDetails: details}},
+	})
+}
+
+func NewSchemaDeprecatedEntry(details *pb.ConfigSchemaDetails, message string, replacementVersion string) (*repository.OutboxEntry, error) {
+	return newSchemaEventEntry(details, &pb.SchemaEvent{
+		Event: &pb.SchemaEvent_Deprecated{Deprecated: &pb.SchemaDeprecated{
+			SchemaDetails:      details,
+			Message:            message,
+			ReplacementVersion: replacementVersion,
+		}},
+	})
+}
+
+func newSchemaEventEntry(details *pb.ConfigSchemaDetails, event *pb.SchemaEvent) (*repository.OutboxEntry, error) {
+	event.EventVersion = SchemaEventVersion
+	event.Time = timestamppb.Now()
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	schemaKey := OortSchemaId(details.GetOrganization(), details.GetNamespace(), details.GetSchemaName(), details.GetVersion())
+	return repository.NewOutboxEntry(OutboxKindSchemaEvent, schemaKey, payload), nil
+}
+
+func (p *SchemaEventPublisher) publish(ctx context.Context, entry *repository.OutboxEntry) error {
+	event := &pb.SchemaEvent{}
+	if err := proto.Unmarshal(entry.Payload, event); err != nil {
+		return err
+	}
+	// the outbox entry id is stable across retries, which lets consumers detect redeliveries
+	event.EventId = entry.Id
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return err
+	}
+	details := repository.GetSchemaDetailsFromKey(entry.SchemaKey)
+	msg := nats.NewMsg(SchemaEventSubject(details.GetOrganization(), details.GetNamespace(), details.GetSchemaName()))
+	msg.Data = data
+	// consumers can continue the trace of the change from the message headers
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(msg.Header))
+	if err := p.conn.PublishMsg(msg); err != nil {
+		return err
+	}
+	return p.conn.FlushTimeout(schemaEventFlushTimeout)
+}
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	natsserver "github.com/nats-io/nats-server/v2/server"
+	natstest "github.com/nats-io/nats-server/v2/test"
+	"github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/proto"
+)
+
+func runNatsServer(t *testing.T) *natsserver.Server {
+	t.Helper()
+	opts := natstest.DefaultTestOptions
+	opts.Port = -1
+	server := natstest.RunServer(&opts)
+	t.Cleanup(server.Shutdown)
+	return server
+}
+
+func connect(t *testing.T, server *natsserver.Server) *nats.Conn {
+	t.Helper()
+	conn, err := nats.Connect(server.ClientURL())
+	if err != nil {
+		t.Fatalf("failed to connect to NATS: %v", err)
+	}
+	t.Cleanup(conn.Close)
+	return conn
+}
+
+func subscribe(t *testing.T, conn *nats.Conn, subject string) *nats.Subscription {
+	t.Helper()
+	sub, err := conn.SubscribeSync(subject)
+	if err != nil {
+		t.Fatalf("failed to subscribe to %s: %v", subject, err)
+	}
+	if err := conn.Flush(); err != nil {
+		t.Fatalf("failed to flush subscription: %v", err)
+	}
+	return sub
+}
+
+func nextEvent(t *testing.T, sub *nats.Subscription) (*nats.Msg, *pb.SchemaEvent) {
+	t.Helper()
+	msg, err := sub.NextMsg(2 * time.Second)
+	if err != nil {
+		t.Fatalf("no event received: %v", err)
+	}
+	event := &pb.SchemaEvent{}
+	if err := proto.Unmarshal(msg.Data, event); err != nil {
+		t.Fatalf("failed to decode event: %v", err)
+	}
+	return msg, event
+}
+
+var testSchemaDetails = &pb.ConfigSchemaDetails{
+	Organization: "c12s",
+	Namespace:    "prod",
+	SchemaName:   "database",
+	Version:      "v1.0.0",
+}
+
+func TestSchemaEventPublisherPublishesEvents(t *testing.T) {
+	server := runNatsServer(t)
+	sub := subscribe(t, connect(t, server), "quasar.>")
+	publisher := NewSchemaEventPublisher(connect(t, server))
+
+	created, err := NewSchemaCreatedEntry(testSchemaDetails)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deleted, err := NewSchemaDeletedEntry(testSchemaDetails)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deprecated, err := NewSchemaDeprecatedEntry(testSchemaDetails, "use v2", "v2.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		entry *repository.OutboxEntry
+		check func(t *testing.T, event *pb.SchemaEvent)
+	}{
+		{
+			name:  "created",
+			entry: created,
+			check: func(t *testing.T, event *pb.SchemaEvent) {
+				if !proto.Equal(event.GetCreated().GetSchemaDetails(), testSchemaDetails) {
+					t.Errorf("expected SchemaCreated for %v, got %v", testSchemaDetails, event.GetEvent())
+				}
+				if event.GetEventId() != created.Id {
+					t.Errorf("expected event id %q, got %q", created.Id, event.GetEventId())
+				}
+			},
+		},
+		{
+			name:  "deleted",
+			entry: deleted,
+			check: func(t *testing.T, event *pb.SchemaEvent) {
+				if !proto.Equal(event.GetDeleted().GetSchemaDetails(), testSchemaDetails) {
+					t.Errorf("expected SchemaDeleted for %v, got %v", testSchemaDetails, event.GetEvent())
+				}
+			},
+		},
+		{
+			name:  "deprecated",
+			entry: deprecated,
+			check: func(t *testing.T, event *pb.SchemaEvent) {
+				deprecation := event.GetDeprecated()
+				if !proto.Equal(deprecation.GetSchemaDetails(), testSchemaDetails) || deprecation.GetMessage() != "use v2" || deprecation.GetReplacementVersion() != "v2.0.0" {
+					t.Errorf("expected SchemaDeprecated for %v, got %v", testSchemaDetails, event.GetEvent())
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := publisher.publish(context.Background(), tt.entry); err != nil {
+				t.Fatalf("failed to publish: %v", err)
+			}
+			msg, event := nextEvent(t, sub)
+			if msg.Subject != "quasar.c12s.prod.database" {
+				t.Errorf("expected subject quasar.c12s.prod.database, got %s", msg.Subject)
+			}
+			if event.GetEventVersion() != SchemaEventVersion {
+				t.Errorf("expected event version %d, got %d", SchemaEventVersion, event.GetEventVersion())
+			}
+			tt.check(t, event)
+		})
+	}
+}
+
+func TestSchemaEventPublisherRedeliveryKeepsEventId(t *testing.T) {
+	server := runNatsServer(t)
+	sub := subscribe(t, connect(t, server), "quasar.c12s.prod.database")
+	entry, err := NewSchemaCreatedEntry(testSchemaDetails)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the first attempt fails, as it would while NATS is unreachable, so the outbox delivers the entry again
+	closed := connect(t, server)
+	closed.Close()
+	if err := NewSchemaEventPublisher(closed).publish(context.Background(), entry); err == nil {
+		t.Fatal("expected publishing over a closed connection to fail")
+	}
+	publisher := NewSchemaEventPublisher(connect(t, server))
+	if err := publisher.publish(context.Background(), entry); err != nil {
+		t.Fatalf("failed to publish: %v", err)
+	}
+	// a delivery whose completion was not recorded is published once more
+	if err := publisher.publish(context.Background(), entry); err != nil {
+		t.Fatalf("failed to publish: %v", err)
+	}
+
+	_, first := nextEvent(t, sub)
+	_, second := nextEvent(t, sub)
+	if first.GetEventId() == "" || first.GetEventId() != entry.Id {
+		t.Errorf("expected event id %q, got %q", entry.Id, first.GetEventId())
+	}
+	if second.GetEventId() != first.GetEventId() {
+		t.Errorf("expected redelivered event to keep id %q, got %q", first.GetEventId(), second.GetEventId())
+	}
+	if !proto.Equal(first.GetTime(), second.GetTime()) {
+		t.Errorf("expected redelivered event to keep time %v, got %v", first.GetTime(), second.GetTime())
+	}
+}
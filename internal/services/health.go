@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	"github.com/nats-io/nats.go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	// HealthLiveness is served as long as the process is running, and should be used by liveness probes.
+	HealthLiveness = ""
+	// HealthReadiness is served while every dependency is available, and should be used by readiness probes.
+	HealthReadiness = "readiness"
+
+	healthCheckTimeout = 3 * time.Second
+)
+
+// DependencyCheck returns an error if the dependency is unavailable.
+type DependencyCheck func(ctx context.Context) error
+
+type dependency struct {
+	name  string
+	check DependencyCheck
+}
+
+// HealthMonitor periodically checks the dependencies of the service and reports their status through the
+// grpc.health.v1 service. Each dependency is reported under its own name, and the readiness of the service
+// (as well as of the services which depend on every dependency) is SERVING only while all of them are available.
+type HealthMonitor struct {
+	server       *health.Server
+	dependencies []dependency
+	services     []string
+}
+
+// NewHealthMonitor reports the readiness of the given services in addition to HealthReadiness.
+func NewHealthMonitor(server *health.Server, services ...string) *HealthMonitor {
+	m := &HealthMonitor{
+		server:   server,
+		services: append([]string{HealthReadiness}, services...),
+	}
+	server.SetServingStatus(HealthLiveness, healthpb.HealthCheckResponse_SERVING)
+	for _, service := range m.services {
+		server.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+	return m
+}
+
+func (m *HealthMonitor) AddDependency(name string, check DependencyCheck) {
+	m.dependencies = append(m.dependencies, dependency{name: name, check: check})
+	m.server.SetServingStatus(name, healthpb.HealthCheckResponse_NOT_SERVING)
+}
+
+// Run checks the dependencies immediately and then periodically, until the context is cancelled.
+func (m *HealthMonitor) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	previous := make(map[string]error)
+	for {
+		ready := healthpb.HealthCheckResponse_SERVING
+		for _, dependency := range m.dependencies {
+			checkCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+			err := dependency.check(checkCtx)
+			cancel()
+			status := healthpb.HealthCheckResponse_SERVING
+			if err != nil {
+				status = healthpb.HealthCheckResponse_NOT_SERVING
+				ready = healthpb.HealthCheckResponse_NOT_SERVING
+			}
+			if last, checked := previous[dependency.name]; !checked || (last == nil) != (err == nil) {
+				if err != nil {
+					slog.Warn("dependency unavailable", "dependency", dependency.name, "error", err)
+				} else {
+					slog.Info("dependency available", "dependency", dependency.name)
+				}
+			}
+			previous[dependency.name] = err
+			m.server.SetServingStatus(dependency.name, status)
+		}
+		for _, service := range m.services {
+			m.server.SetServingStatus(service, ready)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Shutdown reports every service as NOT_SERVING from now on, regardless of the status of the dependencies.
+func (m *HealthMonitor) Shutdown() {
+	m.server.Shutdown()
+}
+
+// EtcdCheck reads from etcd.
+func EtcdCheck(repo *repository.EtcdRepository) DependencyCheck {
+	return func(ctx context.Context) error {
+		return repo.Ping(ctx)
+	}
+}
+
+// NatsCheck reports whether the connection to NATS is established.
+func NatsCheck(conn *nats.Conn) DependencyCheck {
+	return func(ctx context.Context) error {
+		if !conn.IsConnected() {
+			return fmt.Errorf("NATS connection is %s", conn.Status())
+		}
+		return nil
+	}
+}
+
+// GrpcConnCheck reports whether a client connection is ready. Idle connections are asked to connect,
+// so that a dependency which is not called often is still checked.
+func GrpcConnCheck(conn *grpc.ClientConn) DependencyCheck {
+	return func(ctx context.Context) error {
+		state := conn.GetState()
+		if state == connectivity.Idle {
+			conn.Connect()
+		}
+		for state != connectivity.Ready {
+			if !conn.WaitForStateChange(ctx, state) {
+				return fmt.Errorf("connection to %s is %s", conn.Target(), state)
+			}
+			state = conn.GetState()
+		}
+		return nil
+	}
+}
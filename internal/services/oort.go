@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	oortapi "github.com/c12s/oort/pkg/api"
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	"github.com/jtomic1/config-schema-service/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	OutboxKindOortCreateSchemaRel = "oort.create_schema_rel"
+	OutboxKindOortDeleteSchemaRel = "oort.delete_schema_rel"
+	OutboxKindOortDeleteSchema    = "oort.delete_schema"
+)
+
+const oortResponseTimeout = 10 * time.Second
+
+// OortDelivery sends outbox entries to oort and waits for oort to acknowledge them.
+type OortDelivery struct {
+	administrator *oortapi.AdministrationAsyncClient
+	repo          *repository.EtcdRepository
+}
+
+func NewOortDelivery(administrator *oortapi.AdministrationAsyncClient, repo *repository.EtcdRepository) *OortDelivery {
+	return &OortDelivery{
+		administrator: administrator,
+		repo:          repo,
+	}
+}
+
+func (d *OortDelivery) Register(worker *OutboxWorker) {
+	worker.Handle(OutboxKindOortCreateSchemaRel, d.createSchemaRel)
+	worker.Handle(OutboxKindOortDeleteSchemaRel, d.deleteSchemaRel)
+	worker.Handle(OutboxKindOortDeleteSchema, d.deleteSchema)
+}
+
+func NewOortCreateSchemaRelEntry(org, namespace, name, version string) (*repository.OutboxEntry, error) {
+	payload, err := proto.Marshal(&oortapi.CreateInheritanceRelReq{
+		From: &oortapi.Resource{
+			Id:   org,
+			Kind: OortResOrg,
+		},
+		To: &oortapi.Resource{
+			Id:   OortSchemaId(org, namespace, name, version),
+			Kind: OortResSchema,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return repository.NewOutboxEntry(OutboxKindOortCreateSchemaRel, OortSchemaId(org, namespace, name, version), payload), nil
+}
+
+// NewOortDeleteSchemaEntries returns the entries which remove the schema from oort,
+// first detaching it from its organization and then deleting the resource itself.
+func NewOortDeleteSchemaEntries(schemaKey string) ([]*repository.OutboxEntry, error) {
+	details := repository.GetSchemaDetailsFromKey(schemaKey)
+	schemaResource := &oortapi.Resource{
+		Id:   schemaKey,
+		Kind: OortResSchema,
+	}
+	relPayload, err := proto.Marshal(&oortapi.DeleteInheritanceRelReq{
+		From: &oortapi.Resource{
+			Id:   details.GetOrganization(),
+			Kind: OortResOrg,
+		},
+		To: schemaResource,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resourcePayload, err := proto.Marshal(&oortapi.DeleteResourceReq{
+		Resource: schemaResource,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return []*repository.OutboxEntry{
+		repository.NewOutboxEntry(OutboxKindOortDeleteSchemaRel, schemaKey, relPayload),
+		repository.NewOutboxEntry(OutboxKindOortDeleteSchema, schemaKey, resourcePayload),
+	}, nil
+}
+
+func (d *OortDelivery) createSchemaRel(ctx context.Context, entry *repository.OutboxEntry) error {
+	req := &oortapi.CreateInheritanceRelReq{}
+	if err := proto.Unmarshal(entry.Payload, req); err != nil {
+		return err
+	}
+	if err := d.send(ctx, req); err != nil {
+		return err
+	}
+	return d.repo.MarkOortSynced(ctx, entry.SchemaKey)
+}
+
+func (d *OortDelivery) deleteSchemaRel(ctx context.Context, entry *repository.OutboxEntry) error {
+	req := &oortapi.DeleteInheritanceRelReq{}
+	if err := proto.Unmarshal(entry.Payload, req); err != nil {
+		return err
+	}
+	return d.send(ctx, req)
+}
+
+func (d *OortDelivery) deleteSchema(ctx context.Context, entry *repository.OutboxEntry) error {
+	req := &oortapi.DeleteResourceReq{}
+	if err := proto.Unmarshal(entry.Payload, req); err != nil {
+		return err
+	}
+	if err := d.send(ctx, req); err != nil {
+		return err
+	}
+	return d.repo.UnmarkOortSynced(ctx, entry.SchemaKey)
+}
+
+// CollectGarbage schedules the removal of oort schema resources whose schemas no longer exist in etcd
+// and returns the number of resources scheduled for removal. Oort has no API which lists its resources, so
+// the resources are taken from the markers of acknowledged relationships. Resources of schemas which were
+// deleted before acknowledgements were recorded have no marker and are not found.
+func (d *OortDelivery) CollectGarbage(ctx context.Context) (int, error) {
+	orphanedKeys, err := d.repo.GetOrphanedOortKeys(ctx)
+	if err != nil {
+		return 0, err
+	}
+	entries, err := d.repo.GetOutboxEntries(ctx)
+	if err != nil {
+		return 0, err
+	}
+	pendingDeletes := make(map[string]bool)
+	for _, entry := range entries {
+		if entry.Kind == OutboxKindOortDeleteSchema {
+			pendingDeletes[entry.SchemaKey] = true
+		}
+	}
+	var garbage []*repository.OutboxEntry
+	collected := 0
+	for _, key := range orphanedKeys {
+		if pendingDeletes[key] {
+			continue
+		}
+		deleteEntries, err := NewOortDeleteSchemaEntries(key)
+		if err != nil {
+			return 0, err
+		}
+		garbage = append(garbage, deleteEntries...)
+		collected++
+	}
+	if collected == 0 {
+		return 0, nil
+	}
+	if err := d.repo.EnqueueOutboxEntries(ctx, garbage...); err != nil {
+		return 0, err
+	}
+	return collected, nil
+}
+
+// RunGarbageCollection periodically collects orphaned oort schema resources until the context is cancelled.
+func (d *OortDelivery) RunGarbageCollection(ctx context.Context, interval time.Duration, worker *OutboxWorker) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		collected, err := d.CollectGarbage(ctx)
+		if err != nil {
+			slog.Error("error while collecting orphaned oort resources", "error", err)
+			continue
+		}
+		if collected > 0 {
+			slog.Info("scheduled removal of orphaned oort schema resources", "count", collected)
+			worker.Notify()
+		}
+	}
+}
+
+func (d *OortDelivery) send(ctx context.Context, req proto.Message) (err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "oort.send", trace.WithSpanKind(trace.SpanKindProducer), trace.WithAttributes(
+		attribute.String("oort.request", string(proto.MessageName(req))),
+	))
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+	errCh := make(chan error, 1)
+	err = d.administrator.SendRequest(req, func(resp *oortapi.AdministrationAsyncResp) {
+		if resp.Error != "" {
+			errCh <- errors.New(resp.Error)
+		} else {
+			errCh <- nil
+		}
+	})
+	if err != nil {
+		return err
+	}
+	select {
+	case err := <-errCh:
+		return err
+	case <-time.After(oortResponseTimeout):
+		return fmt.Errorf("no response from oort after %s", oortResponseTimeout)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
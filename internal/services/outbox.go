@@ -0,0 +1,151 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jtomic1/config-schema-service/internal/metrics"
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	"github.com/jtomic1/config-schema-service/internal/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	outboxPollInterval = 5 * time.Second
+	outboxMinBackoff   = time.Second
+	outboxMaxBackoff   = 5 * time.Minute
+)
+
+// OutboxHandler delivers a single outbox entry to an external system.
+type OutboxHandler func(ctx context.Context, entry *repository.OutboxEntry) error
+
+// OutboxWorker delivers pending outbox entries in the background,
+// retrying failed deliveries with exponential backoff.
+type OutboxWorker struct {
+	repo     *repository.EtcdRepository
+	handlers map[string]OutboxHandler
+	notify   chan struct{}
+}
+
+func NewOutboxWorker(repo *repository.EtcdRepository) *OutboxWorker {
+	return &OutboxWorker{
+		repo:     repo,
+		handlers: make(map[string]OutboxHandler),
+		notify:   make(chan struct{}, 1),
+	}
+}
+
+func (w *OutboxWorker) Handle(kind string, handler OutboxHandler) {
+	w.handlers[kind] = handler
+}
+
+// Notify wakes the worker up so that newly stored entries are delivered without waiting for the next poll.
+func (w *OutboxWorker) Notify() {
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (w *OutboxWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(outboxPollInterval)
+	defer ticker.Stop()
+	for {
+		w.deliverPending(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-w.notify:
+		}
+	}
+}
+
+// Flush makes a final attempt to deliver the entries which are due, e.g. before the server stops. Entries which
+// are not delivered stay stored and are delivered once a worker runs again. Flush must not run concurrently with Run.
+func (w *OutboxWorker) Flush(ctx context.Context) {
+	w.deliverPending(ctx)
+}
+
+func (w *OutboxWorker) deliverPending(ctx context.Context) {
+	entries, err := w.repo.GetOutboxEntries(ctx)
+	if err != nil {
+		slog.Error("error while retrieving outbox entries", "error", err)
+		return
+	}
+	now := time.Now()
+	// entries of a schema are delivered to each system in order,
+	// so a stream is blocked by its first undelivered entry
+	blocked := make(map[string]bool)
+	for _, entry := range entries {
+		if ctx.Err() != nil {
+			return
+		}
+		stream := outboxStream(entry)
+		if blocked[stream] || entry.NextAttempt.After(now) {
+			blocked[stream] = true
+			continue
+		}
+		handler, ok := w.handlers[entry.Kind]
+		if !ok {
+			slog.Error("no outbox handler registered", "kind", entry.Kind)
+			blocked[stream] = true
+			continue
+		}
+		if err := w.deliver(ctx, handler, entry); err != nil {
+			// a delivery interrupted by the worker stopping is not an attempt, so that Flush delivers it again
+			if ctx.Err() != nil {
+				return
+			}
+			blocked[stream] = true
+			entry.Attempts++
+			entry.LastError = err.Error()
+			entry.NextAttempt = now.Add(outboxBackoff(entry.Attempts))
+			metrics.ObserveDeliveryFailure(entry.Kind)
+			slog.Warn("delivery of outbox entry failed", "entry_id", entry.Id, "attempt", entry.Attempts, "error", err)
+			if err := w.repo.UpdateOutboxEntry(ctx, entry); err != nil {
+				slog.Error("error while updating outbox entry", "entry_id", entry.Id, "error", err)
+			}
+			continue
+		}
+		if err := w.repo.CompleteOutboxEntry(ctx, entry); err != nil {
+			slog.Error("error while completing outbox entry", "entry_id", entry.Id, "error", err)
+		}
+	}
+}
+
+// deliver traces the delivery of an entry as a child of the request which produced it.
+func (w *OutboxWorker) deliver(ctx context.Context, handler OutboxHandler, entry *repository.OutboxEntry) error {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(entry.TraceContext))
+	ctx, span := tracing.Tracer().Start(ctx, "outbox.deliver", trace.WithAttributes(
+		attribute.String("outbox.kind", entry.Kind),
+		attribute.String("outbox.schema_key", entry.SchemaKey),
+		attribute.Int("outbox.attempt", int(entry.Attempts)+1),
+	))
+	defer span.End()
+	err := handler(ctx, entry)
+	tracing.RecordError(span, err)
+	return err
+}
+
+// outboxStream groups entries by the system they are delivered to (the kind prefix) and by schema.
+func outboxStream(entry *repository.OutboxEntry) string {
+	system, _, _ := strings.Cut(entry.Kind, ".")
+	return system + "|" + entry.SchemaKey
+}
+
+func outboxBackoff(attempts int32) time.Duration {
+	backoff := outboxMinBackoff
+	for i := int32(1); i < attempts; i++ {
+		backoff *= 2
+		if backoff >= outboxMaxBackoff {
+			return outboxMaxBackoff
+		}
+	}
+	return backoff
+}
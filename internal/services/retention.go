@@ -0,0 +1,38 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/jtomic1/config-schema-service/internal/repository"
+)
+
+// TombstonePurger permanently deletes schemas whose retention window has expired.
+type TombstonePurger struct {
+	repo *repository.EtcdRepository
+}
+
+func NewTombstonePurger(repo *repository.EtcdRepository) *TombstonePurger {
+	return &TombstonePurger{repo: repo}
+}
+
+// Run purges expired tombstones periodically until the context is cancelled.
+func (p *TombstonePurger) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		purged, err := p.repo.PurgeExpiredTombstones(ctx)
+		if err != nil {
+			slog.Error("error while purging deleted schemas", "error", err)
+		}
+		if purged > 0 {
+			slog.Info("permanently deleted schemas with expired retention", "count", purged)
+		}
+	}
+}
@@ -3,32 +3,42 @@ package validators
 import (
 	"errors"
 	"strings"
+	"unicode"
 
+	"github.com/jtomic1/config-schema-service/internal/semverrange"
+	"github.com/jtomic1/config-schema-service/pkg/validation"
 	pb "github.com/jtomic1/config-schema-service/proto"
-	"github.com/xeipuuv/gojsonschema"
 	"golang.org/x/mod/semver"
-	"sigs.k8s.io/yaml"
 )
 
 func IsSchemaValid(schema string) (bool, error) {
-	if schema == "" {
-		return false, errors.New("schema cannot be empty")
-	}
-	schemaJson, err := yaml.YAMLToJSON([]byte(schema))
-	if err != nil {
+	if _, err := validation.CompileSchema(schema); err != nil {
 		return false, err
 	}
-	loader := gojsonschema.NewStringLoader(string(schemaJson))
-	_, schemaErr := gojsonschema.NewSchema(loader)
-	if schemaErr != nil {
-		return false, schemaErr
-	}
 	return true, nil
 }
 
 func IsConfigurationValid(configuration string) (bool, error) {
 	if configuration == "" {
-		return false, errors.New("configuration cannot be empty")
+		return false, validation.ErrEmptyConfiguration
+	}
+	return true, nil
+}
+
+func AreLabelsAndTagsValid(labels map[string]string, tags []string) (bool, error) {
+	for key := range labels {
+		if key == "" {
+			return false, errors.New("label key cannot be empty")
+		}
+	}
+	seenTags := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		if tag == "" {
+			return false, errors.New("tag cannot be empty")
+		} else if seenTags[tag] {
+			return false, errors.New("tag '" + tag + "' is provided more than once")
+		}
+		seenTags[tag] = true
 	}
 	return true, nil
 }
@@ -48,16 +58,35 @@ func AreSchemaDetailsValid(schemaDetails *pb.ConfigSchemaDetails, isVersionRequi
 	return true, nil
 }
 
+// AreSubjectTokensValid checks that the organization, namespace and name of a new schema can be used as tokens of
+// the NATS subject quasar.<organization>.<namespace>.<schema_name> on which its change events are published.
+// Separators, wildcards and whitespace would change which subscribers receive the events.
+func AreSubjectTokensValid(schemaDetails *pb.ConfigSchemaDetails) (bool, error) {
+	for _, token := range []string{schemaDetails.GetOrganization(), schemaDetails.GetNamespace(), schemaDetails.GetSchemaName()} {
+		if strings.ContainsAny(token, ".*>") || strings.IndexFunc(token, unicode.IsSpace) != -1 {
+			return false, errors.New("organization, namespace and schema name must not contain '.', '*', '>' or whitespace")
+		}
+	}
+	return true, nil
+}
+
 func IsSaveSchemaRequestValid(saveRequest *pb.SaveConfigSchemaRequest) (bool, error) {
 	schemaDetailsValid, schemaDetailsErr := AreSchemaDetailsValid(saveRequest.GetSchemaDetails(), true)
 	if schemaDetailsErr != nil {
 		return false, schemaDetailsErr
 	}
+	if _, err := AreSubjectTokensValid(saveRequest.GetSchemaDetails()); err != nil {
+		return false, err
+	}
 	schemaValid, schemaErr := IsSchemaValid(saveRequest.GetSchema())
 	if schemaErr != nil {
 		return false, schemaErr
 	}
-	requestValid := schemaDetailsValid && schemaValid
+	metadataValid, metadataErr := AreLabelsAndTagsValid(saveRequest.GetLabels(), saveRequest.GetTags())
+	if metadataErr != nil {
+		return false, metadataErr
+	}
+	requestValid := schemaDetailsValid && schemaValid && metadataValid
 	return requestValid, nil
 }
 
@@ -95,5 +124,148 @@ func IsGetConfigSchemaVersionsValid(versionsRequest *pb.ConfigSchemaVersionsRequ
 	if schemaDetailsErr != nil {
 		return false, schemaDetailsErr
 	}
+	filterValid, filterErr := AreLabelsAndTagsValid(versionsRequest.GetFilter().GetLabels(), versionsRequest.GetFilter().GetTags())
+	if filterErr != nil {
+		return false, filterErr
+	}
+	if versionsRequest.GetPageSize() < 0 {
+		return false, errors.New("page size cannot be negative")
+	}
+	if _, err := semverrange.Parse(versionsRequest.GetVersionRange()); err != nil {
+		return false, err
+	}
+	return schemaDetailsValid && filterValid, nil
+}
+
+func IsReconcileOortRelationshipsRequestValid(reconcileRequest *pb.ReconcileOortRelationshipsRequest) (bool, error) {
+	if reconcileRequest.GetOrganization() == "" {
+		return false, errors.New("organization cannot be empty")
+	} else if strings.Contains(reconcileRequest.GetOrganization(), "/") || strings.Contains(reconcileRequest.GetNamespace(), "/") {
+		return false, errors.New("organization and namespace must not contain '/'")
+	}
+	return true, nil
+}
+
+func IsWatchConfigSchemasRequestValid(watchRequest *pb.WatchConfigSchemasRequest) (bool, error) {
+	if watchRequest.GetOrganization() == "" {
+		return false, errors.New("organization cannot be empty")
+	} else if watchRequest.GetSchemaName() != "" && watchRequest.GetNamespace() == "" {
+		return false, errors.New("namespace cannot be empty when schema name is provided")
+	} else if strings.Contains(watchRequest.GetOrganization(), "/") || strings.Contains(watchRequest.GetNamespace(), "/") || strings.Contains(watchRequest.GetSchemaName(), "/") {
+		return false, errors.New("watch prefix must not contain '/'")
+	} else if watchRequest.GetStartRevision() < 0 {
+		return false, errors.New("start revision cannot be negative")
+	}
+	return true, nil
+}
+
+func IsListConfigSchemasRequestValid(listRequest *pb.ListConfigSchemasRequest) (bool, error) {
+	if listRequest.GetOrganization() == "" {
+		return false, errors.New("organization cannot be empty")
+	} else if strings.Contains(listRequest.GetOrganization(), "/") || strings.Contains(listRequest.GetNamespace(), "/") {
+		return false, errors.New("organization and namespace must not contain '/'")
+	} else if listRequest.GetPageSize() < 0 {
+		return false, errors.New("page size cannot be negative")
+	}
+	return AreLabelsAndTagsValid(listRequest.GetFilter().GetLabels(), listRequest.GetFilter().GetTags())
+}
+
+func IsSearchConfigSchemasRequestValid(searchRequest *pb.SearchConfigSchemasRequest) (bool, error) {
+	if searchRequest.GetOrganization() == "" {
+		return false, errors.New("organization cannot be empty")
+	} else if strings.Contains(searchRequest.GetOrganization(), "/") || strings.Contains(searchRequest.GetNamespace(), "/") {
+		return false, errors.New("organization and namespace must not contain '/'")
+	} else if strings.TrimSpace(searchRequest.GetText()) == "" && searchRequest.GetPropertyPath() == "" && len(searchRequest.GetKeywords()) == 0 {
+		return false, errors.New("search query cannot be empty")
+	} else if searchRequest.GetMaxResults() < 0 {
+		return false, errors.New("max results cannot be negative")
+	}
+	return true, nil
+}
+
+func IsSetConfigSchemaLifecycleRequestValid(lifecycleRequest *pb.SetConfigSchemaLifecycleRequest) (bool, error) {
+	schemaDetailsValid, schemaDetailsErr := AreSchemaDetailsValid(lifecycleRequest.GetSchemaDetails(), true)
+	if schemaDetailsErr != nil {
+		return false, schemaDetailsErr
+	}
+	if _, ok := pb.ConfigSchemaState_name[int32(lifecycleRequest.GetState())]; !ok {
+		return false, errors.New("state must be ACTIVE, DEPRECATED or YANKED")
+	}
+	replacementVersion := lifecycleRequest.GetReplacementVersion()
+	if replacementVersion != "" && lifecycleRequest.GetState() != pb.ConfigSchemaState_DEPRECATED {
+		return false, errors.New("replacement version can only be provided for deprecated schemas")
+	} else if replacementVersion != "" && !semver.IsValid(replacementVersion) {
+		return false, errors.New("replacement version must be a valid SemVer string with 'v' prefix")
+	} else if replacementVersion != "" && semver.Compare(replacementVersion, lifecycleRequest.GetSchemaDetails().GetVersion()) == 0 {
+		return false, errors.New("schema version cannot replace itself")
+	}
 	return schemaDetailsValid, nil
 }
+
+func IsRestoreConfigSchemaRequestValid(restoreRequest *pb.RestoreConfigSchemaRequest) (bool, error) {
+	schemaDetailsValid, schemaDetailsErr := AreSchemaDetailsValid(restoreRequest.GetSchemaDetails(), true)
+	if schemaDetailsErr != nil {
+		return false, schemaDetailsErr
+	}
+	return schemaDetailsValid, nil
+}
+
+func IsListDeletedConfigSchemasRequestValid(listRequest *pb.ListDeletedConfigSchemasRequest) (bool, error) {
+	if listRequest.GetOrganization() == "" {
+		return false, errors.New("organization cannot be empty")
+	} else if strings.Contains(listRequest.GetOrganization(), "/") || strings.Contains(listRequest.GetNamespace(), "/") {
+		return false, errors.New("organization and namespace must not contain '/'")
+	}
+	return true, nil
+}
+
+func IsQueryAuditLogRequestValid(queryRequest *pb.QueryAuditLogRequest) (bool, error) {
+	if queryRequest.GetOrganization() == "" {
+		return false, errors.New("organization cannot be empty")
+	} else if queryRequest.GetSchemaName() != "" && queryRequest.GetNamespace() == "" {
+		return false, errors.New("namespace cannot be empty when schema name is provided")
+	} else if queryRequest.GetVersion() != "" && queryRequest.GetSchemaName() == "" {
+		return false, errors.New("schema name cannot be empty when version is provided")
+	} else if strings.Contains(queryRequest.GetOrganization(), "/") || strings.Contains(queryRequest.GetNamespace(), "/") || strings.Contains(queryRequest.GetSchemaName(), "/") || strings.Contains(queryRequest.GetVersion(), "/") {
+		return false, errors.New("organization, namespace, schema name and version must not contain '/'")
+	} else if queryRequest.GetPageSize() < 0 {
+		return false, errors.New("page size cannot be negative")
+	} else if queryRequest.GetStartTime() != nil && queryRequest.GetEndTime() != nil && !queryRequest.GetStartTime().AsTime().Before(queryRequest.GetEndTime().AsTime()) {
+		return false, errors.New("start time must be before end time")
+	}
+	return true, nil
+}
+
+func IsExportConfigSchemasRequestValid(exportRequest *pb.ExportConfigSchemasRequest) (bool, error) {
+	if exportRequest.GetOrganization() == "" {
+		return false, errors.New("organization cannot be empty")
+	} else if exportRequest.GetSchemaName() != "" && exportRequest.GetNamespace() == "" {
+		return false, errors.New("namespace cannot be empty when schema name is provided")
+	} else if strings.Contains(exportRequest.GetOrganization(), "/") || strings.Contains(exportRequest.GetNamespace(), "/") || strings.Contains(exportRequest.GetSchemaName(), "/") {
+		return false, errors.New("export prefix must not contain '/'")
+	} else if _, ok := pb.ArchiveFormat_name[int32(exportRequest.GetFormat())]; !ok {
+		return false, errors.New("unknown archive format")
+	}
+	return true, nil
+}
+
+func IsImportConfigSchemasRequestValid(importRequest *pb.ImportConfigSchemasRequest) (bool, error) {
+	if _, ok := pb.ArchiveFormat_name[int32(importRequest.GetFormat())]; !ok {
+		return false, errors.New("unknown archive format")
+	} else if _, ok := pb.ImportConflictPolicy_name[int32(importRequest.GetConflictPolicy())]; !ok {
+		return false, errors.New("unknown conflict policy")
+	}
+	return true, nil
+}
+
+// IsImportedSchemaValid checks a version read from an archive. Unlike saved schemas, whose organization and
+// namespace are checked with meridian first, the organization and namespace are taken from the archive.
+func IsImportedSchemaValid(saveRequest *pb.SaveConfigSchemaRequest) (bool, error) {
+	schemaDetails := saveRequest.GetSchemaDetails()
+	if schemaDetails.GetOrganization() == "" || schemaDetails.GetNamespace() == "" {
+		return false, errors.New("organization and namespace cannot be empty")
+	} else if strings.Contains(schemaDetails.GetOrganization(), "/") || strings.Contains(schemaDetails.GetNamespace(), "/") {
+		return false, errors.New("organization and namespace must not contain '/'")
+	}
+	return IsSaveSchemaRequestValid(saveRequest)
+}
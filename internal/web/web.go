@@ -0,0 +1,69 @@
+package web
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/cors"
+	"connectrpc.com/vanguard/vanguardgrpc"
+	"github.com/jtomic1/config-schema-service/internal/config"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+)
+
+// NewServer serves the services of the gRPC server over gRPC-Web and the Connect protocol, so that browsers
+// can call them. Requests are transcoded to gRPC and handled by the gRPC server itself, including its
+// interceptors. Without TLS, HTTP/2 is accepted in cleartext (h2c) next to HTTP/1.1.
+func NewServer(addr string, tlsCfg *tls.Config, grpcServer *grpc.Server, corsCfg config.CORSConfig) (*http.Server, error) {
+	transcoder, err := vanguardgrpc.NewTranscoder(grpcServer)
+	if err != nil {
+		return nil, fmt.Errorf("creating transcoder: %w", err)
+	}
+	handler := CORS(corsCfg, transcoder)
+	if tlsCfg == nil {
+		handler = h2c.NewHandler(handler, &http2.Server{})
+	}
+	return &http.Server{
+		Addr:      addr,
+		Handler:   handler,
+		TLSConfig: tlsCfg,
+	}, nil
+}
+
+var (
+	allowedHeaders = append(cors.AllowedHeaders(), "Authorization", "Authz-Token", "X-Request-Id")
+	exposedHeaders = append(cors.ExposedHeaders(), "X-Request-Id")
+)
+
+// CORS lets scripts from the allowed origins call the handler, answering their preflight requests with
+// the methods and headers used by gRPC-Web, Connect and the REST gateway.
+func CORS(cfg config.CORSConfig, next http.Handler) http.Handler {
+	if len(cfg.AllowedOrigins) == 0 {
+		return next
+	}
+	anyOrigin := slices.Contains(cfg.AllowedOrigins, "*")
+	maxAge := fmt.Sprint(int(time.Duration(cfg.MaxAge).Seconds()))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" || !(anyOrigin || slices.Contains(cfg.AllowedOrigins, origin)) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Add("Vary", "Origin")
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			w.Header().Set("Access-Control-Allow-Methods", strings.Join(append(cors.AllowedMethods(), http.MethodPut, http.MethodDelete), ", "))
+			w.Header().Set("Access-Control-Allow-Headers", strings.Join(allowedHeaders, ", "))
+			w.Header().Set("Access-Control-Max-Age", maxAge)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Access-Control-Expose-Headers", strings.Join(exposedHeaders, ", "))
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/jtomic1/config-schema-service/pkg/validation"
+)
+
+// schemaCache keeps the compiled schemas of the most recently used versions. The schema of a version
+// does not change once it is saved, so entries are only evicted to bound the size of the cache, or when
+// the version is deleted through the client.
+type schemaCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type cachedSchema struct {
+	key    string
+	schema *validation.Schema
+}
+
+func newSchemaCache(size int) *schemaCache {
+	return &schemaCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+func (c *schemaCache) get(key string) (*validation.Schema, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(*cachedSchema).schema, true
+}
+
+func (c *schemaCache) add(key string, schema *validation.Schema) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[key]; ok {
+		element.Value.(*cachedSchema).schema = schema
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&cachedSchema{key: key, schema: schema})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cachedSchema).key)
+	}
+}
+
+func (c *schemaCache) remove(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[key]; ok {
+		c.order.Remove(element)
+		delete(c.entries, key)
+	}
+}
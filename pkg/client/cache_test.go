@@ -0,0 +1,40 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/jtomic1/config-schema-service/pkg/validation"
+)
+
+func TestSchemaCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := newSchemaCache(2)
+	schemas := make(map[string]*validation.Schema)
+	for _, key := range []string{"a", "b", "c"} {
+		schema, err := validation.CompileSchema("type: object")
+		if err != nil {
+			t.Fatal(err)
+		}
+		schemas[key] = schema
+	}
+
+	cache.add("a", schemas["a"])
+	cache.add("b", schemas["b"])
+	if _, ok := cache.get("a"); !ok {
+		t.Fatal("expected a to be cached")
+	}
+	// b is now the least recently used entry
+	cache.add("c", schemas["c"])
+	if _, ok := cache.get("b"); ok {
+		t.Error("expected b to be evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if schema, ok := cache.get(key); !ok || schema != schemas[key] {
+			t.Errorf("expected %s to be cached", key)
+		}
+	}
+
+	cache.remove("a")
+	if _, ok := cache.get("a"); ok {
+		t.Error("expected a to be removed")
+	}
+}
@@ -0,0 +1,487 @@
+// Package client is the Go SDK of the config schema service. It sets up the connection, sends the token
+// with every call, turns the status of responses into errors, retries idempotent calls and can validate
+// configurations locally against cached schemas.
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+
+	"github.com/jtomic1/config-schema-service/pkg/validation"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// Client calls the config schema service. It is safe for concurrent use.
+type Client struct {
+	rpc   pb.ConfigSchemaServiceClient
+	conn  *grpc.ClientConn
+	token string
+	retry RetryPolicy
+	cache *schemaCache
+}
+
+type options struct {
+	token       string
+	tlsConfig   *tls.Config
+	dialOptions []grpc.DialOption
+	retry       RetryPolicy
+	cacheSize   int
+}
+
+type Option func(*options)
+
+// WithToken sends the token as the authz-token of every call.
+func WithToken(token string) Option {
+	return func(o *options) {
+		o.token = token
+	}
+}
+
+// WithTLS connects over TLS. A client certificate in the configuration identifies the caller when the
+// server verifies client certificates, in which case no token is needed.
+func WithTLS(tlsConfig *tls.Config) Option {
+	return func(o *options) {
+		o.tlsConfig = tlsConfig
+	}
+}
+
+// WithDialOptions adds options to the connection created by New, e.g. interceptors or a stats handler.
+func WithDialOptions(dialOptions ...grpc.DialOption) Option {
+	return func(o *options) {
+		o.dialOptions = append(o.dialOptions, dialOptions...)
+	}
+}
+
+// WithRetryPolicy replaces DefaultRetryPolicy.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *options) {
+		o.retry = policy
+	}
+}
+
+// WithSchemaCache keeps up to size compiled schemas for ValidateLocal. Without it, ValidateLocal fetches
+// the schema for every call.
+func WithSchemaCache(size int) Option {
+	return func(o *options) {
+		o.cacheSize = size
+	}
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{retry: DefaultRetryPolicy}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// New connects to the service at the target, e.g. "localhost:50051". The connection is established
+// lazily by the first call.
+func New(target string, opts ...Option) (*Client, error) {
+	o := newOptions(opts)
+	creds := insecure.NewCredentials()
+	if o.tlsConfig != nil {
+		creds = credentials.NewTLS(o.tlsConfig)
+	}
+	conn, err := grpc.NewClient(target, append([]grpc.DialOption{grpc.WithTransportCredentials(creds)}, o.dialOptions...)...)
+	if err != nil {
+		return nil, err
+	}
+	c := NewFromConn(conn, opts...)
+	c.conn = conn
+	return c, nil
+}
+
+// NewFromConn creates a client over an existing connection, which is not closed by Close. The TLS and
+// dial options are ignored.
+func NewFromConn(conn grpc.ClientConnInterface, opts ...Option) *Client {
+	o := newOptions(opts)
+	c := &Client{
+		rpc:   pb.NewConfigSchemaServiceClient(conn),
+		token: o.token,
+		retry: o.retry,
+	}
+	if o.cacheSize > 0 {
+		c.cache = newSchemaCache(o.cacheSize)
+	}
+	return c
+}
+
+// Close closes the connection created by New.
+func (c *Client) Close() error {
+	if c.conn == nil {
+		return nil
+	}
+	return c.conn.Close()
+}
+
+// call runs the call with the token, retrying it if it is idempotent. The call returns the error of the
+// call or of the status of the response.
+func (c *Client) call(ctx context.Context, idempotent bool, call func(ctx context.Context) error) error {
+	ctx = c.outgoing(ctx)
+	if !idempotent {
+		return call(ctx)
+	}
+	return c.retry.retry(ctx, func() error {
+		return call(ctx)
+	})
+}
+
+func (c *Client) outgoing(ctx context.Context) context.Context {
+	if c.token == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, "authz-token", c.token)
+}
+
+func schemaKey(details *pb.ConfigSchemaDetails) string {
+	return details.GetOrganization() + "/" + details.GetNamespace() + "/" + details.GetSchemaName() + "/" + details.GetVersion()
+}
+
+// SaveSchema saves a new version of a schema. It is not retried, as saving the same version again fails.
+func (c *Client) SaveSchema(ctx context.Context, req *pb.SaveConfigSchemaRequest) error {
+	return c.call(ctx, false, func(ctx context.Context) error {
+		resp, err := c.rpc.SaveConfigSchema(ctx, req)
+		if err != nil {
+			return callError(err)
+		}
+		return responseError(resp.GetStatus(), resp.GetMessage())
+	})
+}
+
+// GetSchema returns a version of a schema. The service answers a missing version with an OK status and no
+// schema, which is returned as ErrNotFound.
+func (c *Client) GetSchema(ctx context.Context, details *pb.ConfigSchemaDetails) (*pb.ConfigSchemaData, error) {
+	var schemaData *pb.ConfigSchemaData
+	err := c.call(ctx, true, func(ctx context.Context) error {
+		resp, err := c.rpc.GetConfigSchema(ctx, &pb.GetConfigSchemaRequest{SchemaDetails: details})
+		if err != nil {
+			return callError(err)
+		}
+		schemaData = resp.GetSchemaData()
+		if resp.GetStatus() == 0 && schemaData == nil {
+			return &StatusError{Code: codes.NotFound, Message: resp.GetMessage()}
+		}
+		return responseError(resp.GetStatus(), resp.GetMessage())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return schemaData, nil
+}
+
+// DeleteSchema deletes a version of a schema and removes it from the cache.
+func (c *Client) DeleteSchema(ctx context.Context, details *pb.ConfigSchemaDetails) error {
+	if c.cache != nil {
+		c.cache.remove(schemaKey(details))
+	}
+	return c.call(ctx, false, func(ctx context.Context) error {
+		resp, err := c.rpc.DeleteConfigSchema(ctx, &pb.DeleteConfigSchemaRequest{SchemaDetails: details})
+		if err != nil {
+			return callError(err)
+		}
+		return responseError(resp.GetStatus(), resp.GetMessage())
+	})
+}
+
+// ValidationResult is the outcome of validating a configuration. An invalid configuration is not an error.
+type ValidationResult struct {
+	Valid bool
+	// Message is the first violation of the schema, or a confirmation if the configuration is valid.
+	Message string
+	// Warning is set when the version is deprecated or yanked. It is only reported by Validate.
+	Warning string
+}
+
+// Validate validates the configuration against a version of a schema on the server.
+func (c *Client) Validate(ctx context.Context, details *pb.ConfigSchemaDetails, configuration string) (*ValidationResult, error) {
+	var result *ValidationResult
+	err := c.call(ctx, true, func(ctx context.Context) error {
+		resp, err := c.rpc.ValidateConfiguration(ctx, &pb.ValidateConfigurationRequest{
+			SchemaDetails: details,
+			Configuration: configuration,
+		})
+		if err != nil {
+			return callError(err)
+		}
+		result = &ValidationResult{Valid: resp.GetIsValid(), Message: resp.GetMessage(), Warning: resp.GetWarning()}
+		return responseError(resp.GetStatus(), resp.GetMessage())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ValidateLocal validates the configuration like Validate, with the same pipeline as the server, but
+// without a round trip when the schema is cached. The schema is fetched from the server the first time,
+// which requires the same permission.
+func (c *Client) ValidateLocal(ctx context.Context, details *pb.ConfigSchemaDetails, configuration string) (*ValidationResult, error) {
+	if configuration == "" {
+		return nil, &StatusError{Code: codes.InvalidArgument, Message: validation.ErrEmptyConfiguration.Error()}
+	}
+	schema, err := c.compiledSchema(ctx, details)
+	if err != nil {
+		return nil, err
+	}
+	result, err := schema.Validate(configuration)
+	if err != nil {
+		return nil, &StatusError{Code: codes.InvalidArgument, Message: "Error while validating schema!"}
+	}
+	return &ValidationResult{Valid: result.Valid, Message: result.Message}, nil
+}
+
+func (c *Client) compiledSchema(ctx context.Context, details *pb.ConfigSchemaDetails) (*validation.Schema, error) {
+	key := schemaKey(details)
+	if c.cache != nil {
+		if schema, ok := c.cache.get(key); ok {
+			return schema, nil
+		}
+	}
+	schemaData, err := c.GetSchema(ctx, details)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := validation.CompileSchema(schemaData.GetSchema())
+	if err != nil {
+		return nil, &StatusError{Code: codes.InvalidArgument, Message: "Error while validating schema!"}
+	}
+	if c.cache != nil {
+		c.cache.add(key, schema)
+	}
+	return schema, nil
+}
+
+// Versions returns every version of a schema which matches the request, following the pages of the
+// response. The page size of the request sets the size of the pages which are fetched.
+func (c *Client) Versions(ctx context.Context, req *pb.ConfigSchemaVersionsRequest) ([]*pb.ConfigSchema, error) {
+	req = proto.Clone(req).(*pb.ConfigSchemaVersionsRequest)
+	var versions []*pb.ConfigSchema
+	for {
+		var resp *pb.ConfigSchemaVersionsResponse
+		err := c.call(ctx, true, func(ctx context.Context) error {
+			var err error
+			if resp, err = c.rpc.GetConfigSchemaVersions(ctx, req); err != nil {
+				return callError(err)
+			}
+			return responseError(resp.GetStatus(), resp.GetMessage())
+		})
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, resp.GetSchemaVersions()...)
+		if resp.GetNextPageToken() == "" {
+			return versions, nil
+		}
+		req.PageToken = resp.GetNextPageToken()
+	}
+}
+
+// ListSchemas returns every schema of the organization or namespace which matches the request, with its
+// latest version, following the pages of the response.
+func (c *Client) ListSchemas(ctx context.Context, req *pb.ListConfigSchemasRequest) ([]*pb.ConfigSchemaSummary, error) {
+	req = proto.Clone(req).(*pb.ListConfigSchemasRequest)
+	var schemas []*pb.ConfigSchemaSummary
+	for {
+		var resp *pb.ListConfigSchemasResponse
+		err := c.call(ctx, true, func(ctx context.Context) error {
+			var err error
+			if resp, err = c.rpc.ListConfigSchemas(ctx, req); err != nil {
+				return callError(err)
+			}
+			return responseError(resp.GetStatus(), resp.GetMessage())
+		})
+		if err != nil {
+			return nil, err
+		}
+		schemas = append(schemas, resp.GetSchemas()...)
+		if resp.GetNextPageToken() == "" {
+			return schemas, nil
+		}
+		req.PageToken = resp.GetNextPageToken()
+	}
+}
+
+// SearchSchemas returns the latest versions whose properties match the request.
+func (c *Client) SearchSchemas(ctx context.Context, req *pb.SearchConfigSchemasRequest) ([]*pb.ConfigSchemaSearchResult, error) {
+	var results []*pb.ConfigSchemaSearchResult
+	err := c.call(ctx, true, func(ctx context.Context) error {
+		resp, err := c.rpc.SearchConfigSchemas(ctx, req)
+		if err != nil {
+			return callError(err)
+		}
+		results = resp.GetResults()
+		return responseError(resp.GetStatus(), resp.GetMessage())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// SetLifecycle deprecates, yanks or reactivates a version of a schema.
+func (c *Client) SetLifecycle(ctx context.Context, req *pb.SetConfigSchemaLifecycleRequest) error {
+	return c.call(ctx, false, func(ctx context.Context) error {
+		resp, err := c.rpc.SetConfigSchemaLifecycle(ctx, req)
+		if err != nil {
+			return callError(err)
+		}
+		return responseError(resp.GetStatus(), resp.GetMessage())
+	})
+}
+
+// RestoreSchema restores a deleted version of a schema.
+func (c *Client) RestoreSchema(ctx context.Context, details *pb.ConfigSchemaDetails) error {
+	return c.call(ctx, false, func(ctx context.Context) error {
+		resp, err := c.rpc.RestoreConfigSchema(ctx, &pb.RestoreConfigSchemaRequest{SchemaDetails: details})
+		if err != nil {
+			return callError(err)
+		}
+		return responseError(resp.GetStatus(), resp.GetMessage())
+	})
+}
+
+// ListDeletedSchemas returns the deleted versions of the organization or namespace which can still be restored.
+func (c *Client) ListDeletedSchemas(ctx context.Context, organization string, namespace string) ([]*pb.DeletedConfigSchema, error) {
+	var deleted []*pb.DeletedConfigSchema
+	err := c.call(ctx, true, func(ctx context.Context) error {
+		resp, err := c.rpc.ListDeletedConfigSchemas(ctx, &pb.ListDeletedConfigSchemasRequest{
+			Organization: organization,
+			Namespace:    namespace,
+		})
+		if err != nil {
+			return callError(err)
+		}
+		deleted = resp.GetDeletedSchemas()
+		return responseError(resp.GetStatus(), resp.GetMessage())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deleted, nil
+}
+
+// QueryAuditLog returns a page of the audit log. The audit log can be long, so the pages are not followed.
+func (c *Client) QueryAuditLog(ctx context.Context, req *pb.QueryAuditLogRequest) (*pb.QueryAuditLogResponse, error) {
+	var resp *pb.QueryAuditLogResponse
+	err := c.call(ctx, true, func(ctx context.Context) error {
+		var err error
+		if resp, err = c.rpc.QueryAuditLog(ctx, req); err != nil {
+			return callError(err)
+		}
+		return responseError(resp.GetStatus(), resp.GetMessage())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ReconcileOortRelationships reports the versions whose relationships are missing in oort. It is only
+// retried when it does not repair them.
+func (c *Client) ReconcileOortRelationships(ctx context.Context, req *pb.ReconcileOortRelationshipsRequest) ([]*pb.MissingOortRelationship, error) {
+	var missing []*pb.MissingOortRelationship
+	err := c.call(ctx, !req.GetRepair(), func(ctx context.Context) error {
+		resp, err := c.rpc.ReconcileOortRelationships(ctx, req)
+		if err != nil {
+			return callError(err)
+		}
+		missing = resp.GetMissingRelationships()
+		return responseError(resp.GetStatus(), resp.GetMessage())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return missing, nil
+}
+
+// Watch streams the changes of the schemas which match the request until the context is done. Events
+// whose status is not OK end the watch, e.g. when the server shuts down; it is not resumed automatically.
+func (c *Client) Watch(ctx context.Context, req *pb.WatchConfigSchemasRequest) (pb.ConfigSchemaService_WatchConfigSchemasClient, error) {
+	stream, err := c.rpc.WatchConfigSchemas(c.outgoing(ctx), req)
+	if err != nil {
+		return nil, callError(err)
+	}
+	return stream, nil
+}
+
+// archiveChunkSize is the size of the chunks in which archives are sent.
+const archiveChunkSize = 64 << 10
+
+// Export writes the archive of every version under the prefix of the request to w. An error is returned
+// when the export fails or ends before the server reports that it is complete, in which case w holds only
+// part of the archive. Exports are not retried.
+func (c *Client) Export(ctx context.Context, req *pb.ExportConfigSchemasRequest, w io.Writer) error {
+	stream, err := c.rpc.ExportConfigSchemas(c.outgoing(ctx), req)
+	if err != nil {
+		return callError(err)
+	}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return errors.New("export ended before it was complete")
+		} else if err != nil {
+			return callError(err)
+		}
+		if err := responseError(resp.GetStatus(), resp.GetMessage()); err != nil {
+			return err
+		}
+		if len(resp.GetChunk()) == 0 {
+			return nil
+		}
+		if _, err := w.Write(resp.GetChunk()); err != nil {
+			return err
+		}
+	}
+}
+
+// Import sends the archive read from r, with the format, conflict policy and dry run of the options. The
+// response lists the outcome of every version, and it is also returned together with the error when the
+// import is aborted, in which case it lists the conflicting versions.
+func (c *Client) Import(ctx context.Context, options *pb.ImportConfigSchemasRequest, r io.Reader) (*pb.ImportConfigSchemasResponse, error) {
+	// the call is cancelled if the archive cannot be read, so that the server does not import part of it
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.rpc.ImportConfigSchemas(c.outgoing(ctx))
+	if err != nil {
+		return nil, callError(err)
+	}
+	first := true
+	buffer := make([]byte, archiveChunkSize)
+	for {
+		n, readErr := io.ReadFull(r, buffer)
+		if n > 0 || first {
+			req := &pb.ImportConfigSchemasRequest{Chunk: buffer[:n]}
+			if first {
+				req.Format = options.GetFormat()
+				req.ConflictPolicy = options.GetConflictPolicy()
+				req.DryRun = options.GetDryRun()
+				first = false
+			}
+			// a failed send is reported by CloseAndRecv
+			if err := stream.Send(req); err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, callError(err)
+			}
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		} else if readErr != nil {
+			return nil, readErr
+		}
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, callError(err)
+	}
+	return resp, responseError(resp.GetStatus(), resp.GetMessage())
+}
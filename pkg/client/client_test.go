@@ -0,0 +1,219 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeServer answers every call with the response of the handler for its procedure, which is given the
+// number of the call, starting with 1.
+type fakeServer struct {
+	pb.UnimplementedConfigSchemaServiceServer
+	get  func(call int, in *pb.GetConfigSchemaRequest) (*pb.GetConfigSchemaResponse, error)
+	save func(call int) (*pb.SaveConfigSchemaResponse, error)
+
+	mu     sync.Mutex
+	calls  map[string]int
+	tokens []string
+}
+
+func (s *fakeServer) record(ctx context.Context, procedure string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.tokens = append(s.tokens, md.Get("authz-token")...)
+	s.calls[procedure]++
+	return s.calls[procedure]
+}
+
+func (s *fakeServer) callCount(procedure string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[procedure]
+}
+
+func (s *fakeServer) GetConfigSchema(ctx context.Context, in *pb.GetConfigSchemaRequest) (*pb.GetConfigSchemaResponse, error) {
+	return s.get(s.record(ctx, "GetConfigSchema"), in)
+}
+
+func (s *fakeServer) SaveConfigSchema(ctx context.Context, _ *pb.SaveConfigSchemaRequest) (*pb.SaveConfigSchemaResponse, error) {
+	return s.save(s.record(ctx, "SaveConfigSchema"))
+}
+
+var testRetryPolicy = RetryPolicy{
+	MaxAttempts:    4,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     2 * time.Millisecond,
+	Codes:          DefaultRetryPolicy.Codes,
+}
+
+// newTestClient serves the fake server in memory and returns a client connected to it.
+func newTestClient(t *testing.T, server *fakeServer, opts ...Option) *Client {
+	t.Helper()
+	server.calls = make(map[string]int)
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	pb.RegisterConfigSchemaServiceServer(grpcServer, server)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return NewFromConn(conn, append([]Option{WithRetryPolicy(testRetryPolicy)}, opts...)...)
+}
+
+var testDetails = &pb.ConfigSchemaDetails{Organization: "c12s", Namespace: "prod", SchemaName: "database", Version: "v1.0.0"}
+
+func found(in *pb.GetConfigSchemaRequest) (*pb.GetConfigSchemaResponse, error) {
+	return &pb.GetConfigSchemaResponse{
+		Message:    "Schema retrieved successfully!",
+		SchemaData: &pb.ConfigSchemaData{Schema: `{"type": "object", "required": ["port"]}`},
+	}, nil
+}
+
+func TestGetSchemaRetries(t *testing.T) {
+	tests := []struct {
+		name     string
+		get      func(call int, in *pb.GetConfigSchemaRequest) (*pb.GetConfigSchemaResponse, error)
+		calls    int
+		expected error
+	}{
+		{
+			name: "unavailable call",
+			get: func(call int, in *pb.GetConfigSchemaRequest) (*pb.GetConfigSchemaResponse, error) {
+				if call < 3 {
+					return nil, status.Error(codes.Unavailable, "connection refused")
+				}
+				return found(in)
+			},
+			calls: 3,
+		},
+		{
+			name: "aborted response status",
+			get: func(call int, in *pb.GetConfigSchemaRequest) (*pb.GetConfigSchemaResponse, error) {
+				if call == 1 {
+					return &pb.GetConfigSchemaResponse{Status: int32(codes.Aborted), Message: "Aborted!"}, nil
+				}
+				return found(in)
+			},
+			calls: 2,
+		},
+		{
+			name: "attempts run out",
+			get: func(int, *pb.GetConfigSchemaRequest) (*pb.GetConfigSchemaResponse, error) {
+				return nil, status.Error(codes.Unavailable, "connection refused")
+			},
+			calls:    testRetryPolicy.MaxAttempts,
+			expected: ErrUnavailable,
+		},
+		{
+			name: "internal response status is not retried",
+			get: func(int, *pb.GetConfigSchemaRequest) (*pb.GetConfigSchemaResponse, error) {
+				return &pb.GetConfigSchemaResponse{Status: int32(codes.Internal), Message: "Error while retrieving schema!"}, nil
+			},
+			calls:    1,
+			expected: ErrInternal,
+		},
+		{
+			name: "permission denied is not retried",
+			get: func(int, *pb.GetConfigSchemaRequest) (*pb.GetConfigSchemaResponse, error) {
+				return nil, fmt.Errorf("permission denied: config.get")
+			},
+			calls:    1,
+			expected: ErrPermissionDenied,
+		},
+		{
+			name: "missing version",
+			get: func(int, *pb.GetConfigSchemaRequest) (*pb.GetConfigSchemaResponse, error) {
+				return &pb.GetConfigSchemaResponse{Message: "No schema with key 'c12s/prod/database/v1.0.0' found!"}, nil
+			},
+			calls:    1,
+			expected: ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &fakeServer{get: tt.get}
+			c := newTestClient(t, server, WithToken("token"))
+			schemaData, err := c.GetSchema(context.Background(), testDetails)
+			if tt.expected == nil && (err != nil || schemaData == nil) {
+				t.Errorf("expected the schema, got %v", err)
+			} else if tt.expected != nil && !errors.Is(err, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, err)
+			}
+			if calls := server.callCount("GetConfigSchema"); calls != tt.calls {
+				t.Errorf("expected %d calls, got %d", tt.calls, calls)
+			}
+			for _, token := range server.tokens {
+				if token != "token" {
+					t.Errorf("expected every call to carry the token, got %q", token)
+				}
+			}
+		})
+	}
+}
+
+func TestSaveSchemaIsNotRetried(t *testing.T) {
+	server := &fakeServer{save: func(int) (*pb.SaveConfigSchemaResponse, error) {
+		return nil, status.Error(codes.Unavailable, "connection reset")
+	}}
+	c := newTestClient(t, server)
+	err := c.SaveSchema(context.Background(), &pb.SaveConfigSchemaRequest{SchemaDetails: testDetails, Schema: "type: object"})
+	if !errors.Is(err, ErrUnavailable) {
+		t.Errorf("expected %v, got %v", ErrUnavailable, err)
+	}
+	if calls := server.callCount("SaveConfigSchema"); calls != 1 {
+		t.Errorf("expected a single call, got %d", calls)
+	}
+}
+
+func TestValidateLocalCachesSchemas(t *testing.T) {
+	server := &fakeServer{get: func(_ int, in *pb.GetConfigSchemaRequest) (*pb.GetConfigSchemaResponse, error) {
+		return found(in)
+	}}
+	c := newTestClient(t, server, WithSchemaCache(1))
+	other := &pb.ConfigSchemaDetails{Organization: "c12s", Namespace: "prod", SchemaName: "database", Version: "v2.0.0"}
+	for i, details := range []*pb.ConfigSchemaDetails{testDetails, testDetails, other, testDetails} {
+		result, err := c.ValidateLocal(context.Background(), details, "host: localhost")
+		if err != nil {
+			t.Fatalf("validation %d failed: %v", i, err)
+		}
+		if result.Valid {
+			t.Errorf("validation %d: expected the configuration to be invalid", i)
+		}
+	}
+	// the second validation is served from the cache, and the last one fetches the schema which was evicted
+	if calls := server.callCount("GetConfigSchema"); calls != 3 {
+		t.Errorf("expected 3 calls, got %d", calls)
+	}
+}
+
+func TestValidateLocalReportsMissingVersions(t *testing.T) {
+	server := &fakeServer{get: func(int, *pb.GetConfigSchemaRequest) (*pb.GetConfigSchemaResponse, error) {
+		return &pb.GetConfigSchemaResponse{Message: "No schema with key 'c12s/prod/database/v1.0.0' found!"}, nil
+	}}
+	c := newTestClient(t, server, WithSchemaCache(1))
+	if _, err := c.ValidateLocal(context.Background(), testDetails, "port: 5432"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected %v, got %v", ErrNotFound, err)
+	}
+}
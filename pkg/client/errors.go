@@ -0,0 +1,66 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StatusError is returned when the service answers with a status other than OK, either in the status
+// field of the response or as the error of the call.
+type StatusError struct {
+	Code    codes.Code
+	Message string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// GRPCStatus lets status.Code and status.FromError read the code of the error.
+func (e *StatusError) GRPCStatus() *status.Status {
+	return status.New(e.Code, e.Message)
+}
+
+// Is matches the sentinel errors of the client by their code, e.g. errors.Is(err, client.ErrNotFound).
+func (e *StatusError) Is(target error) bool {
+	var statusErr *StatusError
+	return errors.As(target, &statusErr) && statusErr.Message == "" && statusErr.Code == e.Code
+}
+
+var (
+	ErrInvalidArgument  = &StatusError{Code: codes.InvalidArgument}
+	ErrNotFound         = &StatusError{Code: codes.NotFound}
+	ErrPermissionDenied = &StatusError{Code: codes.PermissionDenied}
+	ErrPrecondition     = &StatusError{Code: codes.FailedPrecondition}
+	ErrUnavailable      = &StatusError{Code: codes.Unavailable}
+	ErrInternal         = &StatusError{Code: codes.Internal}
+)
+
+// responseError turns the status field of a response into an error, which is nil for status 0.
+func responseError(code int32, message string) error {
+	if code == 0 {
+		return nil
+	}
+	return &StatusError{Code: codes.Code(code), Message: message}
+}
+
+// callError turns the error of a call into a StatusError. The service reports calls which are not
+// permitted as unknown errors, so they are recognized by their message.
+func callError(err error) error {
+	if err == nil {
+		return nil
+	}
+	s, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	code := s.Code()
+	if code == codes.Unknown && strings.HasPrefix(s.Message(), "permission denied") {
+		code = codes.PermissionDenied
+	}
+	return &StatusError{Code: code, Message: s.Message()}
+}
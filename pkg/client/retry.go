@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// RetryPolicy controls how idempotent calls are retried. Calls which change schemas are never retried,
+// as a repeated save or delete would fail even if the first attempt succeeded.
+type RetryPolicy struct {
+	// MaxAttempts includes the first attempt; 1 disables retries.
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Codes are the codes which are retried, whether they are the error of the call or the status of the response.
+	Codes []codes.Code
+}
+
+// DefaultRetryPolicy retries transient failures. Internal is not retried, as the service also reports
+// failures which would only repeat with that code.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    4,
+	InitialBackoff: 100 * time.Millisecond,
+	MaxBackoff:     2 * time.Second,
+	Codes:          []codes.Code{codes.Unavailable, codes.ResourceExhausted, codes.Aborted},
+}
+
+func (p RetryPolicy) retryable(err error) bool {
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		return false
+	}
+	for _, code := range p.Codes {
+		if statusErr.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the delay before the given retry, with jitter so that clients do not retry in lockstep.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < retry && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
+
+// retry runs the call until it succeeds, fails with a code which is not retried, runs out of attempts or
+// the context is done. The call returns the error of the call or of the status of the response.
+func (p RetryPolicy) retry(ctx context.Context, call func() error) error {
+	err := call()
+	for attempt := 1; attempt < p.MaxAttempts && p.retryable(err); attempt++ {
+		timer := time.NewTimer(p.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		err = call()
+	}
+	return err
+}
@@ -0,0 +1,23 @@
+package client
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoffDoublesUpToTheMaximumWithJitter(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	for retry, expected := range map[int]time.Duration{
+		1:  100 * time.Millisecond,
+		2:  200 * time.Millisecond,
+		4:  800 * time.Millisecond,
+		5:  time.Second,
+		10: time.Second,
+	} {
+		for i := 0; i < 100; i++ {
+			if backoff := policy.backoff(retry); backoff < expected/2 || backoff > expected {
+				t.Fatalf("retry %d: expected a backoff between %s and %s, got %s", retry, expected/2, expected, backoff)
+			}
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// Package validation is the validation pipeline of the config schema service: schemas and configurations
+// written as YAML or JSON are normalized to JSON, and configurations are validated against the schemas
+// with JSON Schema. The server uses this package, so tools which validate with it reach the same verdicts
+// and report the same messages as ValidateConfiguration.
+package validation
+
+import (
+	"errors"
+
+	"github.com/xeipuuv/gojsonschema"
+	"sigs.k8s.io/yaml"
+)
+
+// ValidMessage is the message of a valid configuration.
+const ValidMessage = "The configuration is valid!"
+
+var (
+	ErrEmptySchema        = errors.New("schema cannot be empty")
+	ErrEmptyConfiguration = errors.New("configuration cannot be empty")
+)
+
+// Normalize converts a YAML or JSON document to JSON. Schemas are stored in this form.
+func Normalize(document string) ([]byte, error) {
+	return yaml.YAMLToJSON([]byte(document))
+}
+
+// Schema is a compiled schema, which can validate any number of configurations.
+type Schema struct {
+	schema *gojsonschema.Schema
+}
+
+// CompileSchema parses a schema written as YAML or JSON. An error means that the service would reject
+// the schema when it is saved.
+func CompileSchema(schema string) (*Schema, error) {
+	if schema == "" {
+		return nil, ErrEmptySchema
+	}
+	schemaJson, err := Normalize(schema)
+	if err != nil {
+		return nil, err
+	}
+	compiled, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(string(schemaJson)))
+	if err != nil {
+		return nil, err
+	}
+	return &Schema{schema: compiled}, nil
+}
+
+// Result is the outcome of validating a configuration.
+type Result struct {
+	Valid bool
+	// Message is ValidMessage, or the first violation of the schema, as reported by the service.
+	Message string
+	// Errors holds every violation of the schema.
+	Errors []string
+}
+
+// Validate validates a configuration written as YAML or JSON. An invalid configuration is not an error;
+// errors are returned when the configuration is empty or cannot be parsed.
+func (s *Schema) Validate(configuration string) (*Result, error) {
+	if configuration == "" {
+		return nil, ErrEmptyConfiguration
+	}
+	configurationJson, err := Normalize(configuration)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.schema.Validate(gojsonschema.NewStringLoader(string(configurationJson)))
+	if err != nil {
+		return nil, err
+	}
+	if result.Valid() {
+		return &Result{Valid: true, Message: ValidMessage}, nil
+	}
+	violations := make([]string, len(result.Errors()))
+	for i, violation := range result.Errors() {
+		violations[i] = violation.String()
+	}
+	return &Result{Valid: false, Message: violations[0], Errors: violations}, nil
+}
+
+// Validate compiles the schema and validates the configuration against it.
+func Validate(configuration string, schema string) (*Result, error) {
+	compiled, err := CompileSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	return compiled.Validate(configuration)
+}
@@ -0,0 +1,122 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+)
+
+const yamlSchema = `
+type: object
+properties:
+  port:
+    type: integer
+  host:
+    type: string
+required:
+  - port
+`
+
+const jsonSchema = `{
+  "type": "object",
+  "properties": {"port": {"type": "integer"}, "host": {"type": "string"}},
+  "required": ["port"]
+}`
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name          string
+		schema        string
+		configuration string
+		valid         bool
+		message       string
+		err           error
+	}{
+		{
+			name:          "YAML schema and YAML configuration",
+			schema:        yamlSchema,
+			configuration: "port: 5432\nhost: localhost",
+			valid:         true,
+			message:       ValidMessage,
+		},
+		{
+			name:          "JSON schema and JSON configuration",
+			schema:        jsonSchema,
+			configuration: `{"port": 5432, "host": "localhost"}`,
+			valid:         true,
+			message:       ValidMessage,
+		},
+		{
+			name:          "YAML schema and JSON configuration",
+			schema:        yamlSchema,
+			configuration: `{"port": "5432"}`,
+			message:       "port: Invalid type. Expected: integer, given: string",
+		},
+		{
+			name:          "JSON schema and YAML configuration",
+			schema:        jsonSchema,
+			configuration: "port: \"5432\"",
+			message:       "port: Invalid type. Expected: integer, given: string",
+		},
+		{
+			name:          "missing required property",
+			schema:        yamlSchema,
+			configuration: "host: localhost",
+			message:       "(root): port is required",
+		},
+		{
+			name:          "empty schema",
+			schema:        "",
+			configuration: "port: 5432",
+			err:           ErrEmptySchema,
+		},
+		{
+			name:          "empty configuration",
+			schema:        yamlSchema,
+			configuration: "",
+			err:           ErrEmptyConfiguration,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Validate(tt.configuration, tt.schema)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("expected error %v, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.Valid != tt.valid || result.Message != tt.message {
+				t.Errorf("expected valid %v with message %q, got valid %v with message %q", tt.valid, tt.message, result.Valid, result.Message)
+			}
+		})
+	}
+}
+
+func TestValidateReportsTheFirstViolation(t *testing.T) {
+	result, err := Validate("port: 5432\nhost: 1", yamlSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Valid || len(result.Errors) != 1 || result.Message != result.Errors[0] {
+		t.Fatalf("expected a single violation reported as the message, got %+v", result)
+	}
+
+	result, err = Validate("host: 1", yamlSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Errors) != 2 || result.Message != result.Errors[0] {
+		t.Errorf("expected the message to be the first of two violations, got %+v", result)
+	}
+}
+
+func TestCompileSchemaRejectsInvalidSchemas(t *testing.T) {
+	for _, schema := range []string{"type: [", "type: 5"} {
+		if _, err := CompileSchema(schema); err == nil {
+			t.Errorf("expected schema %q to be rejected", schema)
+		}
+	}
+}
@@ -27,6 +27,16 @@ type ConfigSchemaServiceClient interface {
 	DeleteConfigSchema(ctx context.Context, in *DeleteConfigSchemaRequest, opts ...grpc.CallOption) (*DeleteConfigSchemaResponse, error)
 	ValidateConfiguration(ctx context.Context, in *ValidateConfigurationRequest, opts ...grpc.CallOption) (*ValidateConfigurationResponse, error)
 	GetConfigSchemaVersions(ctx context.Context, in *ConfigSchemaVersionsRequest, opts ...grpc.CallOption) (*ConfigSchemaVersionsResponse, error)
+	ReconcileOortRelationships(ctx context.Context, in *ReconcileOortRelationshipsRequest, opts ...grpc.CallOption) (*ReconcileOortRelationshipsResponse, error)
+	WatchConfigSchemas(ctx context.Context, in *WatchConfigSchemasRequest, opts ...grpc.CallOption) (ConfigSchemaService_WatchConfigSchemasClient, error)
+	ListConfigSchemas(ctx context.Context, in *ListConfigSchemasRequest, opts ...grpc.CallOption) (*ListConfigSchemasResponse, error)
+	SearchConfigSchemas(ctx context.Context, in *SearchConfigSchemasRequest, opts ...grpc.CallOption) (*SearchConfigSchemasResponse, error)
+	SetConfigSchemaLifecycle(ctx context.Context, in *SetConfigSchemaLifecycleRequest, opts ...grpc.CallOption) (*SetConfigSchemaLifecycleResponse, error)
+	RestoreConfigSchema(ctx context.Context, in *RestoreConfigSchemaRequest, opts ...grpc.CallOption) (*RestoreConfigSchemaResponse, error)
+	ListDeletedConfigSchemas(ctx context.Context, in *ListDeletedConfigSchemasRequest, opts ...grpc.CallOption) (*ListDeletedConfigSchemasResponse, error)
+	QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error)
+	ExportConfigSchemas(ctx context.Context, in *ExportConfigSchemasRequest, opts ...grpc.CallOption) (ConfigSchemaService_ExportConfigSchemasClient, error)
+	ImportConfigSchemas(ctx context.Context, opts ...grpc.CallOption) (ConfigSchemaService_ImportConfigSchemasClient, error)
 }
 
 type configSchemaServiceClient struct {
@@ -82,6 +92,167 @@ func (c *configSchemaServiceClient) GetConfigSchemaVersions(ctx context.Context,
 	return out, nil
 }
 
+func (c *configSchemaServiceClient) ReconcileOortRelationships(ctx context.Context, in *ReconcileOortRelationshipsRequest, opts ...grpc.CallOption) (*ReconcileOortRelationshipsResponse, error) {
+	out := new(ReconcileOortRelationshipsResponse)
+	err := c.cc.Invoke(ctx, "/configschema.ConfigSchemaService/ReconcileOortRelationships", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configSchemaServiceClient) WatchConfigSchemas(ctx context.Context, in *WatchConfigSchemasRequest, opts ...grpc.CallOption) (ConfigSchemaService_WatchConfigSchemasClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ConfigSchemaService_ServiceDesc.Streams[0], "/configschema.ConfigSchemaService/WatchConfigSchemas", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &configSchemaServiceWatchConfigSchemasClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ConfigSchemaService_WatchConfigSchemasClient interface {
+	Recv() (*WatchConfigSchemasResponse, error)
+	grpc.ClientStream
+}
+
+type configSchemaServiceWatchConfigSchemasClient struct {
+	grpc.ClientStream
+}
+
+func (x *configSchemaServiceWatchConfigSchemasClient) Recv() (*WatchConfigSchemasResponse, error) {
+	m := new(WatchConfigSchemasResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *configSchemaServiceClient) ListConfigSchemas(ctx context.Context, in *ListConfigSchemasRequest, opts ...grpc.CallOption) (*ListConfigSchemasResponse, error) {
+	out := new(ListConfigSchemasResponse)
+	err := c.cc.Invoke(ctx, "/configschema.ConfigSchemaService/ListConfigSchemas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configSchemaServiceClient) SearchConfigSchemas(ctx context.Context, in *SearchConfigSchemasRequest, opts ...grpc.CallOption) (*SearchConfigSchemasResponse, error) {
+	out := new(SearchConfigSchemasResponse)
+	err := c.cc.Invoke(ctx, "/configschema.ConfigSchemaService/SearchConfigSchemas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configSchemaServiceClient) SetConfigSchemaLifecycle(ctx context.Context, in *SetConfigSchemaLifecycleRequest, opts ...grpc.CallOption) (*SetConfigSchemaLifecycleResponse, error) {
+	out := new(SetConfigSchemaLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/configschema.ConfigSchemaService/SetConfigSchemaLifecycle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configSchemaServiceClient) RestoreConfigSchema(ctx context.Context, in *RestoreConfigSchemaRequest, opts ...grpc.CallOption) (*RestoreConfigSchemaResponse, error) {
+	out := new(RestoreConfigSchemaResponse)
+	err := c.cc.Invoke(ctx, "/configschema.ConfigSchemaService/RestoreConfigSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configSchemaServiceClient) ListDeletedConfigSchemas(ctx context.Context, in *ListDeletedConfigSchemasRequest, opts ...grpc.CallOption) (*ListDeletedConfigSchemasResponse, error) {
+	out := new(ListDeletedConfigSchemasResponse)
+	err := c.cc.Invoke(ctx, "/configschema.ConfigSchemaService/ListDeletedConfigSchemas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configSchemaServiceClient) QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error) {
+	out := new(QueryAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/configschema.ConfigSchemaService/QueryAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configSchemaServiceClient) ExportConfigSchemas(ctx context.Context, in *ExportConfigSchemasRequest, opts ...grpc.CallOption) (ConfigSchemaService_ExportConfigSchemasClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ConfigSchemaService_ServiceDesc.Streams[1], "/configschema.ConfigSchemaService/ExportConfigSchemas", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &configSchemaServiceExportConfigSchemasClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ConfigSchemaService_ExportConfigSchemasClient interface {
+	Recv() (*ExportConfigSchemasResponse, error)
+	grpc.ClientStream
+}
+
+type configSchemaServiceExportConfigSchemasClient struct {
+	grpc.ClientStream
+}
+
+func (x *configSchemaServiceExportConfigSchemasClient) Recv() (*ExportConfigSchemasResponse, error) {
+	m := new(ExportConfigSchemasResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *configSchemaServiceClient) ImportConfigSchemas(ctx context.Context, opts ...grpc.CallOption) (ConfigSchemaService_ImportConfigSchemasClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ConfigSchemaService_ServiceDesc.Streams[2], "/configschema.ConfigSchemaService/ImportConfigSchemas", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &configSchemaServiceImportConfigSchemasClient{stream}
+	return x, nil
+}
+
+type ConfigSchemaService_ImportConfigSchemasClient interface {
+	Send(*ImportConfigSchemasRequest) error
+	CloseAndRecv() (*ImportConfigSchemasResponse, error)
+	grpc.ClientStream
+}
+
+type configSchemaServiceImportConfigSchemasClient struct {
+	grpc.ClientStream
+}
+
+func (x *configSchemaServiceImportConfigSchemasClient) Send(m *ImportConfigSchemasRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *configSchemaServiceImportConfigSchemasClient) CloseAndRecv() (*ImportConfigSchemasResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportConfigSchemasResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ConfigSchemaServiceServer is the server API for ConfigSchemaService service.
 // All implementations must embed UnimplementedConfigSchemaServiceServer
 // for forward compatibility
@@ -91,6 +262,16 @@ type ConfigSchemaServiceServer interface {
 	DeleteConfigSchema(context.Context, *DeleteConfigSchemaRequest) (*DeleteConfigSchemaResponse, error)
 	ValidateConfiguration(context.Context, *ValidateConfigurationRequest) (*ValidateConfigurationResponse, error)
 	GetConfigSchemaVersions(context.Context, *ConfigSchemaVersionsRequest) (*ConfigSchemaVersionsResponse, error)
+	ReconcileOortRelationships(context.Context, *ReconcileOortRelationshipsRequest) (*ReconcileOortRelationshipsResponse, error)
+	WatchConfigSchemas(*WatchConfigSchemasRequest, ConfigSchemaService_WatchConfigSchemasServer) error
+	ListConfigSchemas(context.Context, *ListConfigSchemasRequest) (*ListConfigSchemasResponse, error)
+	SearchConfigSchemas(context.Context, *SearchConfigSchemasRequest) (*SearchConfigSchemasResponse, error)
+	SetConfigSchemaLifecycle(context.Context, *SetConfigSchemaLifecycleRequest) (*SetConfigSchemaLifecycleResponse, error)
+	RestoreConfigSchema(context.Context, *RestoreConfigSchemaRequest) (*RestoreConfigSchemaResponse, error)
+	ListDeletedConfigSchemas(context.Context, *ListDeletedConfigSchemasRequest) (*ListDeletedConfigSchemasResponse, error)
+	QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error)
+	ExportConfigSchemas(*ExportConfigSchemasRequest, ConfigSchemaService_ExportConfigSchemasServer) error
+	ImportConfigSchemas(ConfigSchemaService_ImportConfigSchemasServer) error
 	mustEmbedUnimplementedConfigSchemaServiceServer()
 }
 
@@ -113,6 +294,36 @@ func (UnimplementedConfigSchemaServiceServer) ValidateConfiguration(context.Cont
 func (UnimplementedConfigSchemaServiceServer) GetConfigSchemaVersions(context.Context, *ConfigSchemaVersionsRequest) (*ConfigSchemaVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetConfigSchemaVersions not implemented")
 }
+func (UnimplementedConfigSchemaServiceServer) ReconcileOortRelationships(context.Context, *ReconcileOortRelationshipsRequest) (*ReconcileOortRelationshipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileOortRelationships not implemented")
+}
+func (UnimplementedConfigSchemaServiceServer) WatchConfigSchemas(*WatchConfigSchemasRequest, ConfigSchemaService_WatchConfigSchemasServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchConfigSchemas not implemented")
+}
+func (UnimplementedConfigSchemaServiceServer) ListConfigSchemas(context.Context, *ListConfigSchemasRequest) (*ListConfigSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigSchemas not implemented")
+}
+func (UnimplementedConfigSchemaServiceServer) SearchConfigSchemas(context.Context, *SearchConfigSchemasRequest) (*SearchConfigSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchConfigSchemas not implemented")
+}
+func (UnimplementedConfigSchemaServiceServer) SetConfigSchemaLifecycle(context.Context, *SetConfigSchemaLifecycleRequest) (*SetConfigSchemaLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConfigSchemaLifecycle not implemented")
+}
+func (UnimplementedConfigSchemaServiceServer) RestoreConfigSchema(context.Context, *RestoreConfigSchemaRequest) (*RestoreConfigSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreConfigSchema not implemented")
+}
+func (UnimplementedConfigSchemaServiceServer) ListDeletedConfigSchemas(context.Context, *ListDeletedConfigSchemasRequest) (*ListDeletedConfigSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedConfigSchemas not implemented")
+}
+func (UnimplementedConfigSchemaServiceServer) QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAuditLog not implemented")
+}
+func (UnimplementedConfigSchemaServiceServer) ExportConfigSchemas(*ExportConfigSchemasRequest, ConfigSchemaService_ExportConfigSchemasServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportConfigSchemas not implemented")
+}
+func (UnimplementedConfigSchemaServiceServer) ImportConfigSchemas(ConfigSchemaService_ImportConfigSchemasServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportConfigSchemas not implemented")
+}
 func (UnimplementedConfigSchemaServiceServer) mustEmbedUnimplementedConfigSchemaServiceServer() {}
 
 // UnsafeConfigSchemaServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -216,6 +427,200 @@ func _ConfigSchemaService_GetConfigSchemaVersions_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConfigSchemaService_ReconcileOortRelationships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileOortRelationshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigSchemaServiceServer).ReconcileOortRelationships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/configschema.ConfigSchemaService/ReconcileOortRelationships",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigSchemaServiceServer).ReconcileOortRelationships(ctx, req.(*ReconcileOortRelationshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigSchemaService_WatchConfigSchemas_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchConfigSchemasRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConfigSchemaServiceServer).WatchConfigSchemas(m, &configSchemaServiceWatchConfigSchemasServer{stream})
+}
+
+type ConfigSchemaService_WatchConfigSchemasServer interface {
+	Send(*WatchConfigSchemasResponse) error
+	grpc.ServerStream
+}
+
+type configSchemaServiceWatchConfigSchemasServer struct {
+	grpc.ServerStream
+}
+
+func (x *configSchemaServiceWatchConfigSchemasServer) Send(m *WatchConfigSchemasResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ConfigSchemaService_ListConfigSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigSchemaServiceServer).ListConfigSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/configschema.ConfigSchemaService/ListConfigSchemas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigSchemaServiceServer).ListConfigSchemas(ctx, req.(*ListConfigSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigSchemaService_SearchConfigSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchConfigSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigSchemaServiceServer).SearchConfigSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/configschema.ConfigSchemaService/SearchConfigSchemas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigSchemaServiceServer).SearchConfigSchemas(ctx, req.(*SearchConfigSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigSchemaService_SetConfigSchemaLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConfigSchemaLifecycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigSchemaServiceServer).SetConfigSchemaLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/configschema.ConfigSchemaService/SetConfigSchemaLifecycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigSchemaServiceServer).SetConfigSchemaLifecycle(ctx, req.(*SetConfigSchemaLifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigSchemaService_RestoreConfigSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreConfigSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigSchemaServiceServer).RestoreConfigSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/configschema.ConfigSchemaService/RestoreConfigSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigSchemaServiceServer).RestoreConfigSchema(ctx, req.(*RestoreConfigSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigSchemaService_ListDeletedConfigSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedConfigSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigSchemaServiceServer).ListDeletedConfigSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/configschema.ConfigSchemaService/ListDeletedConfigSchemas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigSchemaServiceServer).ListDeletedConfigSchemas(ctx, req.(*ListDeletedConfigSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigSchemaService_QueryAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigSchemaServiceServer).QueryAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/configschema.ConfigSchemaService/QueryAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigSchemaServiceServer).QueryAuditLog(ctx, req.(*QueryAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigSchemaService_ExportConfigSchemas_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportConfigSchemasRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConfigSchemaServiceServer).ExportConfigSchemas(m, &configSchemaServiceExportConfigSchemasServer{stream})
+}
+
+type ConfigSchemaService_ExportConfigSchemasServer interface {
+	Send(*ExportConfigSchemasResponse) error
+	grpc.ServerStream
+}
+
+type configSchemaServiceExportConfigSchemasServer struct {
+	grpc.ServerStream
+}
+
+func (x *configSchemaServiceExportConfigSchemasServer) Send(m *ExportConfigSchemasResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ConfigSchemaService_ImportConfigSchemas_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ConfigSchemaServiceServer).ImportConfigSchemas(&configSchemaServiceImportConfigSchemasServer{stream})
+}
+
+type ConfigSchemaService_ImportConfigSchemasServer interface {
+	SendAndClose(*ImportConfigSchemasResponse) error
+	Recv() (*ImportConfigSchemasRequest, error)
+	grpc.ServerStream
+}
+
+type configSchemaServiceImportConfigSchemasServer struct {
+	grpc.ServerStream
+}
+
+func (x *configSchemaServiceImportConfigSchemasServer) SendAndClose(m *ImportConfigSchemasResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *configSchemaServiceImportConfigSchemasServer) Recv() (*ImportConfigSchemasRequest, error) {
+	m := new(ImportConfigSchemasRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ConfigSchemaService_ServiceDesc is the grpc.ServiceDesc for ConfigSchemaService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -243,7 +648,51 @@ var ConfigSchemaService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetConfigSchemaVersions",
 			Handler:    _ConfigSchemaService_GetConfigSchemaVersions_Handler,
 		},
+		{
+			MethodName: "ReconcileOortRelationships",
+			Handler:    _ConfigSchemaService_ReconcileOortRelationships_Handler,
+		},
+		{
+			MethodName: "ListConfigSchemas",
+			Handler:    _ConfigSchemaService_ListConfigSchemas_Handler,
+		},
+		{
+			MethodName: "SearchConfigSchemas",
+			Handler:    _ConfigSchemaService_SearchConfigSchemas_Handler,
+		},
+		{
+			MethodName: "SetConfigSchemaLifecycle",
+			Handler:    _ConfigSchemaService_SetConfigSchemaLifecycle_Handler,
+		},
+		{
+			MethodName: "RestoreConfigSchema",
+			Handler:    _ConfigSchemaService_RestoreConfigSchema_Handler,
+		},
+		{
+			MethodName: "ListDeletedConfigSchemas",
+			Handler:    _ConfigSchemaService_ListDeletedConfigSchemas_Handler,
+		},
+		{
+			MethodName: "QueryAuditLog",
+			Handler:    _ConfigSchemaService_QueryAuditLog_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchConfigSchemas",
+			Handler:       _ConfigSchemaService_WatchConfigSchemas_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportConfigSchemas",
+			Handler:       _ConfigSchemaService_ExportConfigSchemas_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportConfigSchemas",
+			Handler:       _ConfigSchemaService_ImportConfigSchemas_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "config_schema.proto",
 }
@@ -3,4 +3,7 @@ protoc  --proto_path=./ \
         --go_opt=paths=source_relative \
         --go-grpc_out=. \
         --go-grpc_opt=paths=source_relative \
+        --grpc-gateway_out=. \
+        --grpc-gateway_opt=paths=source_relative \
+        --openapiv2_out=. \
         config_schema.proto
\ No newline at end of file
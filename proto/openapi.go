@@ -0,0 +1,8 @@
+package proto
+
+import _ "embed"
+
+// OpenAPI is the OpenAPI document of the REST gateway, generated from config_schema.proto.
+//
+//go:embed config_schema.swagger.json
+var OpenAPI []byte